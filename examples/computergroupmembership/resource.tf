@@ -0,0 +1,16 @@
+# The group itself sets no computers blocks, so its members are left to the membership resources,
+# which may live in different workspaces.
+resource "jamfpro_computer_group" "lab_machines" {
+  name     = "Lab Machines"
+  is_smart = false
+}
+
+resource "jamfpro_computer_group_membership" "design_lab" {
+  group_id     = jamfpro_computer_group.lab_machines.id
+  computer_ids = [12, 15, 21]
+}
+
+resource "jamfpro_computer_group_membership" "engineering_lab" {
+  group_id     = jamfpro_computer_group.lab_machines.id
+  computer_ids = [33, 34]
+}
@@ -0,0 +1,20 @@
+resource "jamfpro_computer_group" "lab_macs" {
+  name     = "Lab Macs"
+  is_smart = false
+
+  computers {
+    serial_number = "C02XK1ABCDEF"
+  }
+
+  computers {
+    name = "LAB-MAC-02"
+  }
+
+  computers {
+    id = 42
+  }
+}
+
+output "lab_macs_unresolved_computers" {
+  value = jamfpro_computer_group.lab_macs.unresolved_computers
+}
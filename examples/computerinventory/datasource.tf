@@ -0,0 +1,18 @@
+data "jamfpro_computer_inventory" "lab_mac" {
+  serial_number = "C02XK1ABCDEF"
+  sections      = ["general", "hardware", "operating_system"]
+}
+
+output "lab_mac_os_version" {
+  value = data.jamfpro_computer_inventory.lab_mac.operating_system[0].version
+}
+
+data "jamfpro_computers_inventory" "apple_silicon" {
+  filter   = "hardware.appleSilicon==true;general.name==\"Lab*\""
+  sort     = "general.name:asc"
+  sections = ["general", "hardware"]
+}
+
+output "apple_silicon_serial_numbers" {
+  value = { for computer in data.jamfpro_computers_inventory.apple_silicon.computers : computer.general[0].name => computer.hardware[0].serial_number }
+}
@@ -0,0 +1,24 @@
+resource "jamfpro_computer_prestage_scope" "hardware_refresh" {
+  prestage_id = jamfpro_computer_prestage_enrollment.jamfpro_computer_prestage_enrollment_001.id
+
+  serial_numbers = [
+    "C02XK1ABJGH5",
+    "C02XK1ABJGH6",
+    "C02XK1ABJGH7",
+  ]
+}
+
+# Only adds and removes the serial numbers listed here, leaving assignments made in the Jamf Pro
+# UI or by other tools in place.
+resource "jamfpro_computer_prestage_scope" "loaners" {
+  prestage_id = "2"
+  mode        = "additive"
+
+  serial_numbers = [
+    "C02LOANER001",
+  ]
+}
+
+output "serials_waiting_for_abm" {
+  value = jamfpro_computer_prestage_scope.hardware_refresh.unassigned_serial_numbers
+}
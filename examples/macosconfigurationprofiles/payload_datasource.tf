@@ -0,0 +1,22 @@
+data "jamfpro_macos_configuration_profile_payload" "dock" {
+  display_name = "Dock"
+  identifier   = "com.example.dock"
+  organization = "Example Org"
+  scope        = "System"
+
+  content {
+    type         = "com.apple.dock"
+    display_name = "Dock settings"
+    settings = jsonencode({
+      tilesize      = 48
+      magnification = true
+      orientation   = "left"
+    })
+  }
+}
+
+resource "jamfpro_macos_configuration_profile" "dock" {
+  name    = "Dock"
+  payload = data.jamfpro_macos_configuration_profile_payload.dock.payload
+  # ...
+}
@@ -0,0 +1,22 @@
+data "jamfpro_mobile_device_inventory" "classroom_ipad" {
+  serial_number = "DMPXK1ABCDEF"
+  sections      = ["general", "hardware", "group_memberships"]
+}
+
+output "classroom_ipad_os_version" {
+  value = data.jamfpro_mobile_device_inventory.classroom_ipad.general[0].os_version
+}
+
+output "classroom_ipad_groups" {
+  value = [for group in data.jamfpro_mobile_device_inventory.classroom_ipad.group_memberships : group.group_name]
+}
+
+data "jamfpro_mobile_devices_inventory" "supervised_ipads" {
+  filter   = "general.supervised==true;hardware.model==\"iPad*\""
+  sort     = "general.displayName:asc"
+  sections = ["general", "hardware"]
+}
+
+output "supervised_ipad_serial_numbers" {
+  value = { for device in data.jamfpro_mobile_devices_inventory.supervised_ipads.mobile_devices : device.general[0].display_name => device.hardware[0].serial_number }
+}
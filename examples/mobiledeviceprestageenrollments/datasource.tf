@@ -0,0 +1,11 @@
+data "jamfpro_mobile_device_prestage_enrollment" "shared_ipads_data" {
+  id = jamfpro_mobile_device_prestage_enrollment.shared_ipads.id
+}
+
+output "shared_ipads_data_display_name" {
+  value = data.jamfpro_mobile_device_prestage_enrollment.shared_ipads_data.display_name
+}
+
+output "shared_ipads_data_device_enrollment_program_instance_id" {
+  value = data.jamfpro_mobile_device_prestage_enrollment.shared_ipads_data.device_enrollment_program_instance_id
+}
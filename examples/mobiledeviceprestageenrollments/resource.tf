@@ -0,0 +1,71 @@
+resource "jamfpro_mobile_device_prestage_enrollment" "shared_ipads" {
+  display_name                          = "Shared iPads - Classrooms"
+  mandatory                             = true
+  mdm_removable                         = false
+  support_phone_number                  = "555-0100"
+  support_email_address                 = "it@example.com"
+  department                            = "Education"
+  default_prestage                      = false
+  enrollment_site_id                    = "-1"
+  keep_existing_site_membership         = false
+  keep_existing_location_information    = false
+  require_authentication                = false
+  authentication_prompt                 = ""
+  prevent_activation_lock               = true
+  enable_device_based_activation_lock   = false
+  device_enrollment_program_instance_id = "1"
+  auto_advance_setup                    = false
+  supervised                            = true
+  multi_user                            = true
+  maximum_shared_accounts               = 10
+  send_timezone                         = true
+  timezone                              = "Europe/London"
+  language                              = "en"
+  region                                = "GB"
+
+  prestage_installed_profile_ids = ["12"]
+
+  skip_setup_items {
+    apple_id              = true
+    icloud_storage        = true
+    imessage_and_facetime = true
+    location              = true
+    passcode              = true
+    payment               = true
+    screen_time           = true
+    siri                  = true
+    terms_of_address      = true
+  }
+
+  names {
+    assign_names_using = "Serial Numbers"
+    device_name_prefix = "CLASS-"
+    manage_names       = true
+  }
+
+  location_information {
+    id       = "-1"
+    username = ""
+    realname = ""
+    phone    = ""
+    email    = ""
+    room     = ""
+    position = ""
+  }
+
+  purchasing_information {
+    id                 = "-1"
+    leased             = false
+    purchased          = true
+    apple_care_id      = ""
+    po_number          = ""
+    vendor             = ""
+    purchase_price     = ""
+    life_expectancy    = 0
+    purchasing_account = ""
+    purchasing_contact = ""
+    lease_date         = "1970-01-01"
+    po_date            = "1970-01-01"
+    warranty_date      = "1970-01-01"
+  }
+}
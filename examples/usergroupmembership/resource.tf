@@ -0,0 +1,17 @@
+# The group itself sets no users block, so its users are left to the membership resources, which
+# may live in different workspaces.
+resource "jamfpro_user_group" "beta_testers" {
+  name                = "Beta Testers"
+  is_smart            = false
+  is_notify_on_change = false
+}
+
+resource "jamfpro_user_group_membership" "mobile_team" {
+  group_id = jamfpro_user_group.beta_testers.id
+  user_ids = [4, 7]
+}
+
+resource "jamfpro_user_group_membership" "desktop_team" {
+  group_id = jamfpro_user_group.beta_testers.id
+  user_ids = [9]
+}
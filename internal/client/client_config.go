@@ -3,6 +3,7 @@ package client
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/stretchr/testify/mock"
@@ -16,6 +17,15 @@ type MockAPIClient struct {
 // APIClient wraps the Jamf Pro SDK Client.
 type APIClient struct {
 	Conn *jamfpro.Client // Use the SDK client
+	// UploadHTTPClient sends requests made outside the SDK client, such as JCDS uploads, through
+	// the configured proxy. It is nil when the proxy settings of the environment apply.
+	UploadHTTPClient *http.Client
+	// DefaultSite and DefaultCategory are applied to resources that do not set a site or category.
+	// They are nil when the provider has no default.
+	DefaultSite     *DefaultReference
+	DefaultCategory *DefaultReference
+	// Mutations restricts the resources that may be created, updated and deleted.
+	Mutations MutationPolicy
 }
 
 // This function maps the string log level from the Terraform configuration
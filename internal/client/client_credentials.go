@@ -0,0 +1,217 @@
+// client_credentials.go
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// credentialProcessTimeout bounds how long a credential process may run.
+const credentialProcessTimeout = time.Minute
+
+// Credentials are the credentials the provider authenticates to Jamf Pro with, either a client ID
+// and secret for OAuth or a username and password for bearer tokens.
+type Credentials struct {
+	ClientID     string    `json:"client_id"`
+	ClientSecret string    `json:"client_secret"`
+	Username     string    `json:"username"`
+	Password     string    `json:"password"`
+	Expiry       time.Time `json:"expiry"` // Zero when the credentials do not expire
+}
+
+// complete reports whether the credentials hold a client ID and secret or a username and password.
+func (c Credentials) complete() bool {
+	return (c.ClientID != "" && c.ClientSecret != "") || (c.Username != "" && c.Password != "")
+}
+
+// CredentialSource provides the credentials used each time the Jamf Pro HTTP client requests a
+// token, so that credentials rotated outside Terraform are picked up without reconfiguring it.
+type CredentialSource interface {
+	Credentials() (Credentials, error)
+}
+
+// CredentialFiles reads the client secret and password from files, such as those mounted by a
+// secret store, each time credentials are requested. The client ID and username are fixed.
+type CredentialFiles struct {
+	ClientID         string
+	ClientSecretFile string
+	Username         string
+	PasswordFile     string
+}
+
+// Credentials reads the secret files.
+func (f CredentialFiles) Credentials() (Credentials, error) {
+	credentials := Credentials{ClientID: f.ClientID, Username: f.Username}
+
+	var err error
+	if f.ClientSecretFile != "" {
+		if credentials.ClientSecret, err = readSecretFile(f.ClientSecretFile); err != nil {
+			return credentials, err
+		}
+	}
+	if f.PasswordFile != "" {
+		if credentials.Password, err = readSecretFile(f.PasswordFile); err != nil {
+			return credentials, err
+		}
+	}
+	return credentials, nil
+}
+
+// readSecretFile returns the contents of a secret file without surrounding whitespace, such as the
+// trailing newline most tools write.
+func readSecretFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file '%s': %v", path, err)
+	}
+	secret := strings.TrimSpace(string(content))
+	if secret == "" {
+		return "", fmt.Errorf("secret file '%s' is empty", path)
+	}
+	return secret, nil
+}
+
+// CredentialProcess runs an external command that prints credentials as a JSON object with
+// client_id and client_secret, or username and password, and an optional RFC 3339 expiry. The
+// credentials are kept until they are within the refresh buffer of their expiry, or for the life of
+// the provider when they have none.
+type CredentialProcess struct {
+	Command       string
+	RefreshBuffer time.Duration
+
+	mu      sync.Mutex
+	current *Credentials
+}
+
+// NewCredentialProcess returns a credential source that runs command through the system shell.
+func NewCredentialProcess(command string, refreshBuffer time.Duration) *CredentialProcess {
+	return &CredentialProcess{Command: command, RefreshBuffer: refreshBuffer}
+}
+
+// Credentials returns the current credentials, running the command when there are none or they
+// are about to expire.
+func (p *CredentialProcess) Credentials() (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.current != nil && (p.current.Expiry.IsZero() || time.Until(p.current.Expiry) > p.RefreshBuffer) {
+		return *p.current, nil
+	}
+
+	credentials, err := p.run()
+	if err != nil {
+		return Credentials{}, err
+	}
+	p.current = &credentials
+	return credentials, nil
+}
+
+// run runs the command and parses its output.
+func (p *CredentialProcess) run() (Credentials, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.Command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		// The output is not included, as it may hold credentials
+		return Credentials{}, fmt.Errorf("credential process failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var credentials Credentials
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		return Credentials{}, fmt.Errorf("credential process output is not a JSON object with client_id and client_secret or username and password: %v", err)
+	}
+	if !credentials.complete() {
+		return Credentials{}, fmt.Errorf("credential process output must include client_id and client_secret, or username and password")
+	}
+	if !credentials.Expiry.IsZero() && time.Until(credentials.Expiry) <= p.RefreshBuffer {
+		return Credentials{}, fmt.Errorf("credential process returned credentials that expire at %s, within the token refresh buffer", credentials.Expiry.Format(time.RFC3339))
+	}
+	return credentials, nil
+}
+
+// credentialTransport sets the credentials of a CredentialSource on the token requests of the Jamf
+// Pro HTTP client, which otherwise sends the credentials it was built with for its whole life.
+type credentialTransport struct {
+	next            http.RoundTripper
+	source          CredentialSource
+	oauthTokenPath  string
+	bearerTokenPath string
+}
+
+// NewCredentialTransport returns a transport that sends requests through next, or the default
+// transport when it is nil, with the current credentials of source on requests for OAuth tokens
+// at oauthTokenPath and bearer tokens at bearerTokenPath.
+func NewCredentialTransport(next http.RoundTripper, source CredentialSource, oauthTokenPath, bearerTokenPath string) http.RoundTripper {
+	if next == nil {
+		next = defaultTransport
+	}
+	return &credentialTransport{next: next, source: source, oauthTokenPath: oauthTokenPath, bearerTokenPath: bearerTokenPath}
+}
+
+// RoundTrip sends the request, replacing the credentials of token requests.
+func (t *credentialTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost {
+		return t.next.RoundTrip(req)
+	}
+
+	switch {
+	case strings.HasSuffix(req.URL.Path, t.oauthTokenPath):
+		credentials, err := t.source.Credentials()
+		if err != nil {
+			closeBody(req)
+			return nil, err
+		}
+		form := url.Values{}
+		form.Set("client_id", credentials.ClientID)
+		form.Set("client_secret", credentials.ClientSecret)
+		form.Set("grant_type", "client_credentials")
+		body := form.Encode()
+
+		closeBody(req)
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(strings.NewReader(body))
+		req.ContentLength = int64(len(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(body)), nil
+		}
+
+	case strings.HasSuffix(req.URL.Path, t.bearerTokenPath):
+		credentials, err := t.source.Credentials()
+		if err != nil {
+			closeBody(req)
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// closeBody closes the body of a request that is not sent as is, as a transport must.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
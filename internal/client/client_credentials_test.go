@@ -0,0 +1,269 @@
+// client_credentials_test.go
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// countingProcess returns a credential process that prints output and appends a line to a file on
+// each run, with a function returning how many times it has run.
+func countingProcess(t *testing.T, output string, refreshBuffer time.Duration) (*CredentialProcess, func() int) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command is a POSIX shell command")
+	}
+
+	dir := t.TempDir()
+	outputFile := filepath.Join(dir, "output.json")
+	runsFile := filepath.Join(dir, "runs")
+	if err := os.WriteFile(outputFile, []byte(output), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	process := NewCredentialProcess(fmt.Sprintf("echo run >> '%s' && cat '%s'", runsFile, outputFile), refreshBuffer)
+	runs := func() int {
+		content, err := os.ReadFile(runsFile)
+		if err != nil {
+			return 0
+		}
+		return strings.Count(string(content), "run\n")
+	}
+	return process, runs
+}
+
+func TestCredentialProcessCredentials(t *testing.T) {
+	expiry := func(d time.Duration) string {
+		return time.Now().Add(d).UTC().Format(time.RFC3339)
+	}
+
+	tests := []struct {
+		name    string
+		output  string
+		buffer  time.Duration
+		wantErr string
+		want    Credentials
+	}{
+		{
+			name:   "client credentials without expiry",
+			output: `{"client_id": "id", "client_secret": "secret"}`,
+			want:   Credentials{ClientID: "id", ClientSecret: "secret"},
+		},
+		{
+			name:   "username and password",
+			output: `{"username": "admin", "password": "hunter2"}`,
+			want:   Credentials{Username: "admin", Password: "hunter2"},
+		},
+		{
+			name:    "expiring within the refresh buffer",
+			output:  fmt.Sprintf(`{"client_id": "id", "client_secret": "secret", "expiry": %q}`, expiry(time.Minute)),
+			buffer:  5 * time.Minute,
+			wantErr: "within the token refresh buffer",
+		},
+		{
+			name:    "incomplete",
+			output:  `{"client_id": "id"}`,
+			wantErr: "must include client_id and client_secret",
+		},
+		{
+			name:    "not JSON",
+			output:  `client_id=id`,
+			wantErr: "is not a JSON object",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			process, _ := countingProcess(t, tc.output, tc.buffer)
+			credentials, err := process.Credentials()
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if credentials != tc.want {
+				t.Fatalf("credentials are %+v, want %+v", credentials, tc.want)
+			}
+		})
+	}
+}
+
+func TestCredentialProcessCachesUntilRefreshBuffer(t *testing.T) {
+	process, runs := countingProcess(t, fmt.Sprintf(`{"client_id": "id", "client_secret": "secret", "expiry": %q}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)), 5*time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if _, err := process.Credentials(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := runs(); n != 1 {
+		t.Fatalf("the credential process ran %d times, want once while the credentials are fresh", n)
+	}
+
+	// Credentials within the refresh buffer of their expiry are replaced
+	process.current.Expiry = time.Now().Add(time.Minute)
+	if _, err := process.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if n := runs(); n != 2 {
+		t.Fatalf("the credential process ran %d times, want twice once the credentials are due to expire", n)
+	}
+}
+
+func TestCredentialProcessWithoutExpiryRunsOnce(t *testing.T) {
+	process, runs := countingProcess(t, `{"client_id": "id", "client_secret": "secret"}`, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		if _, err := process.Credentials(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := runs(); n != 1 {
+		t.Fatalf("the credential process ran %d times, want once", n)
+	}
+}
+
+func TestCredentialProcessFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command is a POSIX shell command")
+	}
+
+	process := NewCredentialProcess(`echo '{"client_id": "id", "client_secret": "leaked"}'; echo denied >&2; exit 3`, 0)
+	_, err := process.Credentials()
+	if err == nil {
+		t.Fatal("expected an error from a failing credential process")
+	}
+	if !strings.Contains(err.Error(), "denied") {
+		t.Errorf("error %q does not include the standard error of the process", err)
+	}
+	if strings.Contains(err.Error(), "leaked") {
+		t.Errorf("error %q includes the output of the process", err)
+	}
+}
+
+// staticCredentials is a CredentialSource returning fixed credentials or an error.
+type staticCredentials struct {
+	credentials Credentials
+	err         error
+}
+
+func (s staticCredentials) Credentials() (Credentials, error) {
+	return s.credentials, s.err
+}
+
+// recordingTransport records the requests it is sent and answers each with an empty 200 response.
+type recordingTransport struct {
+	requests []*http.Request
+	bodies   []string
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		content, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(content)
+	}
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+}
+
+func newTestRequest(t *testing.T, method, rawURL, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, rawURL, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestCredentialTransportRoundTrip(t *testing.T) {
+	source := staticCredentials{credentials: Credentials{ClientID: "rotated-id", ClientSecret: "rotated-secret", Username: "rotated-user", Password: "rotated-password"}}
+	next := &recordingTransport{}
+	transport := NewCredentialTransport(next, source, "/api/oauth/token", "/api/v1/auth/token")
+
+	t.Run("OAuth token", func(t *testing.T) {
+		req := newTestRequest(t, http.MethodPost, "https://jamf.example.com/api/oauth/token", "client_id=old&client_secret=old&grant_type=client_credentials")
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+		sent := next.requests[len(next.requests)-1]
+		form, err := url.ParseQuery(next.bodies[len(next.bodies)-1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if form.Get("client_id") != "rotated-id" || form.Get("client_secret") != "rotated-secret" || form.Get("grant_type") != "client_credentials" {
+			t.Fatalf("token request body is %v, want the rotated client credentials", form)
+		}
+		if sent.ContentLength != int64(len(next.bodies[len(next.bodies)-1])) {
+			t.Errorf("content length is %d, want %d", sent.ContentLength, len(next.bodies[len(next.bodies)-1]))
+		}
+		if sent.GetBody == nil {
+			t.Error("the token request cannot be replayed, as GetBody is not set")
+		}
+		if sent == req {
+			t.Error("the original request was modified rather than cloned")
+		}
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		req := newTestRequest(t, http.MethodPost, "https://jamf.example.com/api/v1/auth/token", "")
+		req.SetBasicAuth("old-user", "old-password")
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+		username, password, ok := next.requests[len(next.requests)-1].BasicAuth()
+		if !ok || username != "rotated-user" || password != "rotated-password" {
+			t.Fatalf("token request authenticates as %q/%q, want the rotated username and password", username, password)
+		}
+		if username, _, _ := req.BasicAuth(); username != "old-user" {
+			t.Error("the original request was modified rather than cloned")
+		}
+	})
+
+	t.Run("other requests", func(t *testing.T) {
+		for _, req := range []*http.Request{
+			newTestRequest(t, http.MethodGet, "https://jamf.example.com/api/oauth/token", ""),
+			newTestRequest(t, http.MethodPost, "https://jamf.example.com/JSSResource/sites/id/0", "<site/>"),
+		} {
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Fatal(err)
+			}
+			if sent := next.requests[len(next.requests)-1]; sent != req {
+				t.Errorf("%s %s was not sent unchanged", req.Method, req.URL.Path)
+			}
+		}
+		if body := next.bodies[len(next.bodies)-1]; body != "<site/>" {
+			t.Errorf("request body is %q, want it unchanged", body)
+		}
+	})
+}
+
+func TestCredentialTransportSourceError(t *testing.T) {
+	next := &recordingTransport{}
+	transport := NewCredentialTransport(next, staticCredentials{err: errors.New("vault is sealed")}, "/api/oauth/token", "/api/v1/auth/token")
+
+	req := newTestRequest(t, http.MethodPost, "https://jamf.example.com/api/oauth/token", "client_id=old")
+	if _, err := transport.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "vault is sealed") {
+		t.Fatalf("expected the credential source error, got %v", err)
+	}
+	if len(next.requests) != 0 {
+		t.Fatalf("%d requests were sent after the credential source failed", len(next.requests))
+	}
+}
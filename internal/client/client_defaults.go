@@ -0,0 +1,91 @@
+// client_defaults.go
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// Reference is a Jamf Pro object referred to by ID and name, such as a site or category.
+type Reference struct {
+	ID   int
+	Name string
+}
+
+// DefaultReference is the default site or category of the provider, configured by ID, name or
+// both. When only one is configured, the other is looked up in Jamf Pro the first time it is needed.
+type DefaultReference struct {
+	ID   int
+	Name string
+
+	lookup func(conn *jamfpro.Client, id int, name string) (Reference, error)
+
+	mu       sync.Mutex
+	resolved *Reference
+}
+
+// NewDefaultSite returns the default site with the given ID or name.
+func NewDefaultSite(id int, name string) *DefaultReference {
+	return &DefaultReference{ID: id, Name: name, lookup: lookupSite}
+}
+
+// NewDefaultCategory returns the default category with the given ID or name.
+func NewDefaultCategory(id int, name string) *DefaultReference {
+	return &DefaultReference{ID: id, Name: name, lookup: lookupCategory}
+}
+
+// Resolve returns the ID and name of the default, looking up the one that is not configured.
+func (r *DefaultReference) Resolve(conn *jamfpro.Client) (Reference, error) {
+	if r.ID != 0 && r.Name != "" {
+		return Reference{ID: r.ID, Name: r.Name}, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.resolved != nil {
+		return *r.resolved, nil
+	}
+	reference, err := r.lookup(conn, r.ID, r.Name)
+	if err != nil {
+		return Reference{}, err
+	}
+	r.resolved = &reference
+	return reference, nil
+}
+
+func lookupSite(conn *jamfpro.Client, id int, name string) (Reference, error) {
+	var site *jamfpro.SharedResourceSite
+	var err error
+	if id != 0 {
+		site, err = conn.GetSiteByID(id)
+	} else {
+		site, err = conn.GetSiteByName(name)
+	}
+	if err != nil {
+		return Reference{}, fmt.Errorf("failed to look up the default site (ID: %d, name: '%s'): %v", id, name, err)
+	}
+	return Reference{ID: site.ID, Name: site.Name}, nil
+}
+
+func lookupCategory(conn *jamfpro.Client, id int, name string) (Reference, error) {
+	var category *jamfpro.ResourceCategory
+	var err error
+	if id != 0 {
+		category, err = conn.GetCategoryByID(strconv.Itoa(id))
+	} else {
+		category, err = conn.GetCategoryByName(name)
+	}
+	if err != nil {
+		return Reference{}, fmt.Errorf("failed to look up the default category (ID: %d, name: '%s'): %v", id, name, err)
+	}
+
+	categoryID, err := strconv.Atoi(category.Id)
+	if err != nil {
+		return Reference{}, fmt.Errorf("error converting the ID '%s' of the default category to int: %v", category.Id, err)
+	}
+	return Reference{ID: categoryID, Name: category.Name}, nil
+}
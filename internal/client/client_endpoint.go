@@ -0,0 +1,58 @@
+// client_endpoint.go
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-http-client/logger"
+)
+
+// BaseURLHandler is an API handler that builds request URLs from a full Jamf Pro base URL, such as
+// https://jss.example.com:8443/jamf, rather than from an instance name and base domain, which
+// assume https on the default port with no path prefix.
+type BaseURLHandler struct {
+	httpclient.APIHandler
+	BaseURL *url.URL
+}
+
+// ParseBaseURL parses a Jamf Pro base URL. It must be an http or https URL with a host, and may
+// have a port and a path prefix but no query or fragment.
+func ParseBaseURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Jamf Pro URL '%s': %v", raw, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("invalid Jamf Pro URL '%s': the scheme must be https or http", raw)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid Jamf Pro URL '%s': a host is required", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return nil, fmt.Errorf("invalid Jamf Pro URL '%s': it must not have credentials, a query or a fragment", raw)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u, nil
+}
+
+// ConstructAPIResourceEndpoint returns the URL of a Jamf Pro API or Classic API endpoint.
+func (h *BaseURLHandler) ConstructAPIResourceEndpoint(instanceName string, endpointPath string, log logger.Logger) string {
+	return h.endpoint(endpointPath, log, "resource")
+}
+
+// ConstructAPIAuthEndpoint returns the URL of a Jamf Pro token endpoint.
+func (h *BaseURLHandler) ConstructAPIAuthEndpoint(instanceName string, endpointPath string, log logger.Logger) string {
+	return h.endpoint(endpointPath, log, "authentication")
+}
+
+func (h *BaseURLHandler) endpoint(endpointPath string, log logger.Logger, kind string) string {
+	endpoint := h.BaseURL.String() + "/" + strings.TrimPrefix(endpointPath, "/")
+	if log != nil {
+		log.Debug(fmt.Sprintf("Constructed Jamf Pro API %s URL: %s", kind, endpoint))
+	}
+	return endpoint
+}
@@ -0,0 +1,31 @@
+// client_mutations.go
+package client
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MutationPolicy restricts which resources the provider may create, update and delete. Reads,
+// imports and data sources are never restricted, so plans work under any policy.
+type MutationPolicy struct {
+	ReadOnly             bool     // Blocks every create, update and delete
+	AllowedResourceTypes []string // When not empty, only these resource types may be changed
+	DeniedResourceTypes  []string // These resource types may not be changed
+}
+
+// Check returns an error explaining why operation, such as "create", may not be applied to a
+// resource of resourceType, or nil when it is allowed.
+func (p MutationPolicy) Check(resourceType, operation string) error {
+	if p.ReadOnly {
+		return fmt.Errorf("the provider is configured with 'read_only', so %s cannot %s objects in Jamf Pro. Unset 'read_only' to apply changes", resourceType, operation)
+	}
+	if len(p.AllowedResourceTypes) > 0 && !slices.Contains(p.AllowedResourceTypes, resourceType) {
+		return fmt.Errorf("%s is not in the provider's 'allowed_resource_types' (%s), so it cannot %s objects in Jamf Pro", resourceType, strings.Join(p.AllowedResourceTypes, ", "), operation)
+	}
+	if slices.Contains(p.DeniedResourceTypes, resourceType) {
+		return fmt.Errorf("%s is in the provider's 'denied_resource_types', so it cannot %s objects in Jamf Pro", resourceType, operation)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// client_proxy.go
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyOptions configures the outbound proxy used for Jamf Pro API requests and JCDS uploads.
+type ProxyOptions struct {
+	URL      string   // URL of the proxy, such as http://proxy.example.com:3128
+	Username string   // Username for proxy authentication, overriding any in URL
+	Password string   // Password for proxy authentication, overriding any in URL
+	NoProxy  []string // Hosts, domains and CIDR ranges that are not sent through the proxy
+}
+
+// IsZero reports whether no proxy is configured, so the proxy settings of the environment apply.
+func (o ProxyOptions) IsZero() bool {
+	return o.URL == ""
+}
+
+// ParseProxyURL parses a proxy URL. It must be an http, https or socks5 URL with a host.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		// The error quotes the URL, which may hold credentials
+		return nil, fmt.Errorf("invalid proxy URL")
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL '%s': the scheme must be http, https or socks5", withoutCredentials(u))
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL '%s': a host is required", withoutCredentials(u))
+	}
+	return u, nil
+}
+
+// withoutCredentials returns u without its user information, for use in errors and logs.
+func withoutCredentials(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	return redacted.String()
+}
+
+// ProxyURL returns the proxy URL with the configured credentials.
+func (o ProxyOptions) ProxyURL() (*url.URL, error) {
+	u, err := ParseProxyURL(o.URL)
+	if err != nil {
+		return nil, err
+	}
+	if o.Username != "" {
+		u.User = url.UserPassword(o.Username, o.Password)
+	}
+	return u, nil
+}
+
+// Redacted returns the proxy URL without credentials, for logging.
+func (o ProxyOptions) Redacted() string {
+	u, err := ParseProxyURL(o.URL)
+	if err != nil {
+		return ""
+	}
+	return withoutCredentials(u)
+}
+
+// ProxyFunc returns a function for http.Transport.Proxy that sends requests through the proxy,
+// except for requests to hosts matched by NoProxy, which uses the syntax of the NO_PROXY
+// environment variable.
+func (o ProxyOptions) ProxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	u, err := o.ProxyURL()
+	if err != nil {
+		return nil, err
+	}
+
+	config := httpproxy.Config{
+		HTTPProxy:  u.String(),
+		HTTPSProxy: u.String(),
+		NoProxy:    strings.Join(o.NoProxy, ","),
+	}
+	proxy := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
@@ -0,0 +1,112 @@
+// client_transport.go
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"unsafe"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+)
+
+// TLSOptions configures how the provider verifies Jamf Pro and authenticates to it at the TLS layer.
+type TLSOptions struct {
+	CABundleFile          string // PEM file of CA certificates trusted in addition to the system roots
+	ClientCertificateFile string // PEM file of the client certificate presented for mutual TLS
+	ClientKeyFile         string // PEM file of the private key of the client certificate
+	InsecureSkipVerify    bool   // Disables verification of the server certificate
+}
+
+// IsZero reports whether no TLS options are set, so the default transport can be used unchanged.
+func (o TLSOptions) IsZero() bool {
+	return o == TLSOptions{}
+}
+
+// TLSConfig builds the TLS configuration for the options.
+func (o TLSOptions) TLSConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.InsecureSkipVerify, // #nosec G402 -- only when explicitly configured
+	}
+
+	if o.CABundleFile != "" {
+		pem, err := os.ReadFile(o.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle file '%s': %v", o.CABundleFile, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle file '%s' does not contain any PEM encoded certificates", o.CABundleFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if o.ClientCertificateFile != "" || o.ClientKeyFile != "" {
+		if o.ClientCertificateFile == "" || o.ClientKeyFile == "" {
+			return nil, fmt.Errorf("both a client certificate file and a client key file are required for mutual TLS")
+		}
+		certificate, err := tls.LoadX509KeyPair(o.ClientCertificateFile, o.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate '%s' and key '%s': %v", o.ClientCertificateFile, o.ClientKeyFile, err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+// defaultTransport is http.DefaultTransport at start up, which transports built by the provider are
+// based on.
+var defaultTransport = http.DefaultTransport
+
+// SetTransport sends every request conn makes, including its token requests, through transport.
+//
+// The Jamf Pro HTTP client does not expose its http.Client, which leaves Transport unset and so
+// uses http.DefaultTransport. Setting the transport on the client itself, rather than on
+// http.DefaultTransport, keeps the TLS, proxy and credential settings of each provider
+// configuration to its own requests, even when several of them point at the same Jamf Pro host.
+func SetTransport(conn *httpclient.Client, transport http.RoundTripper) error {
+	field := reflect.ValueOf(conn).Elem().FieldByName("httpClient")
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*http.Client)(nil)) {
+		return fmt.Errorf("the Jamf Pro HTTP client does not keep its http.Client in the expected field, so its transport cannot be set")
+	}
+	// #nosec G103 -- the field is checked to be an *http.Client above
+	httpClient := *(**http.Client)(unsafe.Pointer(field.UnsafeAddr()))
+	if httpClient == nil {
+		return fmt.Errorf("the Jamf Pro HTTP client has no http.Client, so its transport cannot be set")
+	}
+	httpClient.Transport = transport
+	return nil
+}
+
+// NewTransport returns a transport with the settings of the default transport, the TLS
+// configuration of tlsOptions and, unless it is zero, the proxy of proxyOptions.
+func NewTransport(tlsOptions TLSOptions, proxyOptions ProxyOptions) (*http.Transport, error) {
+	config, err := tlsOptions.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	base, ok := defaultTransport.(*http.Transport)
+	if !ok {
+		base = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	transport := base.Clone()
+	transport.TLSClientConfig = config
+
+	if !proxyOptions.IsZero() {
+		proxy, err := proxyOptions.ProxyFunc()
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = proxy
+	}
+	return transport, nil
+}
@@ -0,0 +1,49 @@
+// accountgroups_list_data_source.go
+package accountgroups
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAccountGroupsList lists the Jamf Pro account groups, optionally filtered by name or site.
+func DataSourceJamfProAccountGroupsList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Account Groups",
+		Lister:     listAccountGroups,
+		SiteGetter: getAccountGroupSiteID,
+	}
+	return list.DataSource()
+}
+
+// listAccountGroups returns the ID and name of every Jamf Pro account group.
+func listAccountGroups(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Groups))
+	for _, group := range response.Groups {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(group.ID), Name: group.Name})
+	}
+	return items, nil
+}
+
+// getAccountGroupSiteID returns the ID of the site a account group belongs to.
+func getAccountGroupSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetAccountGroupByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.Site), nil
+}
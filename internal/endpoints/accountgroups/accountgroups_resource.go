@@ -5,12 +5,13 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	util "github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/type_assertion"
@@ -35,6 +36,13 @@ func ResourceJamfProAccountGroups() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetAccountGroupByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -188,14 +196,14 @@ func ResourceJamfProAccountGroupCreate(ctx context.Context, d *schema.ResourceDa
 		var apiErr error
 		creationResponse, apiErr = conn.CreateAccountGroup(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Account Group '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Account Group '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -254,7 +262,7 @@ func ResourceJamfProAccountGroupRead(ctx context.Context, d *schema.ResourceData
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -266,7 +274,7 @@ func ResourceJamfProAccountGroupRead(ctx context.Context, d *schema.ResourceData
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Account Group with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -357,14 +365,14 @@ func ResourceJamfProAccountGroupUpdate(ctx context.Context, d *schema.ResourceDa
 		_, apiErr := conn.UpdateAccountGroupByID(resourceIDInt, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Account Group '%s' (ID: %s) after retries: %v", resource.Name, resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Account Group '%s' (ID: %s)", resource.Name, resourceID))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -404,8 +412,8 @@ func ResourceJamfProAccountGroupDelete(ctx context.Context, d *schema.ResourceDa
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteAccountGroupByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -413,7 +421,7 @@ func ResourceJamfProAccountGroupDelete(ctx context.Context, d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Account Group '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Account Group '%s' (ID: %s)", d.Get("name").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -9,6 +9,8 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -24,12 +26,15 @@ func DataSourceJamfProAccounts() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the jamf pro account.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the jamf pro account.",
 			},
 			"name": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The name of the jamf pro account.",
 			},
@@ -48,7 +53,10 @@ func DataSourceJamfProAccountRead(ctx context.Context, d *schema.ResourceData, m
 
 	// Initialize variables
 	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Account", "name", listAccounts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Convert resourceID from string to int
 	resourceIDInt, err := strconv.Atoi(resourceID)
@@ -63,8 +71,8 @@ func DataSourceJamfProAccountRead(ctx context.Context, d *schema.ResourceData, m
 		var apiErr error
 		resource, apiErr = conn.GetAccountByID(resourceIDInt)
 		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+			// Retry transient API errors, stop on permanent ones
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully read the resource, exit the retry loop
 		return nil
@@ -72,7 +80,7 @@ func DataSourceJamfProAccountRead(ctx context.Context, d *schema.ResourceData, m
 
 	if err != nil {
 		// Handle the final error after all retries have been exhausted
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Account with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Account with ID '%s'", resourceID))
 	}
 
 	// Check if resource data exists and set the Terraform state
@@ -0,0 +1,49 @@
+// accounts_list_data_source.go
+package accounts
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAccountsList lists the Jamf Pro accounts, optionally filtered by name or site.
+func DataSourceJamfProAccountsList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Accounts",
+		Lister:     listAccounts,
+		SiteGetter: getAccountSiteID,
+	}
+	return list.DataSource()
+}
+
+// listAccounts returns the ID and name of every Jamf Pro account.
+func listAccounts(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Users))
+	for _, account := range response.Users {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(account.ID), Name: account.Name})
+	}
+	return items, nil
+}
+
+// getAccountSiteID returns the ID of the site a account belongs to.
+func getAccountSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetAccountByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.Site), nil
+}
@@ -16,6 +16,7 @@ func constructJamfProAccount(d *schema.ResourceData) (*jamfpro.ResourceAccount,
 		DirectoryUser:       d.Get("directory_user").(bool),
 		FullName:            d.Get("full_name").(string),
 		Email:               d.Get("email").(string),
+		EmailAddress:        d.Get("email_address").(string),
 		Enabled:             d.Get("enabled").(string),
 		ForcePasswordChange: d.Get("force_password_change").(bool),
 		AccessLevel:         d.Get("access_level").(string),
@@ -5,12 +5,13 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	util "github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/type_assertion"
@@ -34,6 +35,13 @@ func ResourceJamfProAccounts() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetAccountByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -63,7 +71,8 @@ func ResourceJamfProAccounts() *schema.Resource {
 			"email_address": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The email address of the account user.",
+				Computed:    true,
+				Description: "The email address of the account user. Read from Jamf Pro when not set.",
 			},
 			"enabled": {
 				Type:        schema.TypeString,
@@ -276,14 +285,14 @@ func ResourceJamfProAccountCreate(ctx context.Context, d *schema.ResourceData, m
 		var apiErr error
 		creationResponse, apiErr = conn.CreateAccount(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Account '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Account '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -342,7 +351,7 @@ func ResourceJamfProAccountRead(ctx context.Context, d *schema.ResourceData, met
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -354,7 +363,7 @@ func ResourceJamfProAccountRead(ctx context.Context, d *schema.ResourceData, met
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Account with ID '%s'", resourceID))
 	}
 
 	// Update Terraform state with the resource information
@@ -374,6 +383,9 @@ func ResourceJamfProAccountRead(ctx context.Context, d *schema.ResourceData, met
 	if err := d.Set("email", resource.Email); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	if err := d.Set("email_address", resource.EmailAddress); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	if err := d.Set("enabled", resource.Enabled); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -474,14 +486,14 @@ func ResourceJamfProAccountUpdate(ctx context.Context, d *schema.ResourceData, m
 		_, apiErr := conn.UpdateAccountByID(resourceIDInt, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Account '%s' (ID: %s) after retries: %v", resource.Name, resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Account '%s' (ID: %s)", resource.Name, resourceID))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -521,8 +533,8 @@ func ResourceJamfProAccountDelete(ctx context.Context, d *schema.ResourceData, m
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteAccountByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -530,7 +542,7 @@ func ResourceJamfProAccountDelete(ctx context.Context, d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Account '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Account '%s' (ID: %s)", d.Get("name").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
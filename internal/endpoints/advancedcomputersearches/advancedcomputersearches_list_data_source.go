@@ -0,0 +1,49 @@
+// advancedcomputersearches_list_data_source.go
+package advancedcomputersearches
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAdvancedComputerSearchesList lists the Jamf Pro advanced computer searches, optionally filtered by name or site.
+func DataSourceJamfProAdvancedComputerSearchesList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Advanced Computer Searches",
+		Lister:     listAdvancedComputerSearches,
+		SiteGetter: getAdvancedComputerSearchSiteID,
+	}
+	return list.DataSource()
+}
+
+// listAdvancedComputerSearches returns the ID and name of every Jamf Pro advanced computer search.
+func listAdvancedComputerSearches(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetAdvancedComputerSearches()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.AdvancedComputerSearches))
+	for _, search := range response.AdvancedComputerSearches {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(search.ID), Name: search.Name})
+	}
+	return items, nil
+}
+
+// getAdvancedComputerSearchSiteID returns the ID of the site a advanced computer search belongs to.
+func getAdvancedComputerSearchSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetAdvancedComputerSearchByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.Site), nil
+}
@@ -59,6 +59,9 @@ func constructJamfProAdvancedComputerSearch(d *schema.ResourceData) (*jamfpro.Re
 			ID:   siteData["id"].(int),
 			Name: siteData["name"].(string),
 		}
+	} else {
+		// Without a site block the object is taken out of any site it is in
+		search.Site = jamfpro.SharedResourceSite{ID: -1, Name: "None"}
 	}
 
 	// Serialize and pretty-print the Advanced Computer Search object as XML for logging
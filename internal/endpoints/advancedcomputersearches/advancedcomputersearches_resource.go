@@ -5,14 +5,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/criteria"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -30,6 +34,17 @@ func ResourceJamfProAdvancedComputerSearches() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		CustomizeDiff: customdiff.All(
+			criteria.CustomizeDiff(criteria.Computers, nil),
+			defaults.CustomizeDiff(defaults.Field{Key: "site", Kind: defaults.Site, Format: defaults.Block, Unset: []interface{}{}}),
+		),
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetAdvancedComputerSearchByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -114,6 +129,7 @@ func ResourceJamfProAdvancedComputerSearches() *schema.Resource {
 			"site": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -158,14 +174,14 @@ func ResourceJamfProAdvancedComputerSearchCreate(ctx context.Context, d *schema.
 		var apiErr error
 		creationResponse, apiErr = conn.CreateAdvancedComputerSearch(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Advanced Computer Search '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Advanced Computer Search '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -220,7 +236,7 @@ func ResourceJamfProAdvancedComputerSearchRead(ctx context.Context, d *schema.Re
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -232,7 +248,7 @@ func ResourceJamfProAdvancedComputerSearchRead(ctx context.Context, d *schema.Re
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Advanced Computer Search with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -291,12 +307,15 @@ func ResourceJamfProAdvancedComputerSearchRead(ctx context.Context, d *schema.Re
 		}
 	}
 
-	// Handle "site" field
-	site := map[string]interface{}{
-		"id":   resource.Site.ID,
-		"name": resource.Site.Name,
+	// Handle "site" field, which Jamf Pro reports with the ID -1 for objects without a site
+	site := []interface{}{}
+	if resource.Site.ID > 0 {
+		site = append(site, map[string]interface{}{
+			"id":   resource.Site.ID,
+			"name": resource.Site.Name,
+		})
 	}
-	if err := d.Set("site", []interface{}{site}); err != nil {
+	if err := d.Set("site", site); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -333,14 +352,14 @@ func ResourceJamfProAdvancedComputerSearchUpdate(ctx context.Context, d *schema.
 		_, apiErr := conn.UpdateAdvancedComputerSearchByID(resourceIDInt, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Advanced Computer Search '%s' (ID: %s) after retries: %v", resource.Name, resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Advanced Computer Search '%s' (ID: %s)", resource.Name, resourceID))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -380,8 +399,8 @@ func ResourceJamfProAdvancedComputerSearchDelete(ctx context.Context, d *schema.
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteAdvancedComputerSearchByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -389,7 +408,7 @@ func ResourceJamfProAdvancedComputerSearchDelete(ctx context.Context, d *schema.
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Advanced Computer Search '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Advanced Computer Search '%s' (ID: %s)", d.Get("name").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
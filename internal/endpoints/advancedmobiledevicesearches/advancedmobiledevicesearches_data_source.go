@@ -8,6 +8,8 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,12 +21,15 @@ func DataSourceJamfProAdvancedMobileDeviceSearches() *schema.Resource {
 		ReadContext: DataSourceJamfProAdvancedMobileDeviceSearchesRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API integration.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the API integration.",
 			},
 			"name": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The unique name of the advanced mobile user search.",
 			},
@@ -32,9 +37,9 @@ func DataSourceJamfProAdvancedMobileDeviceSearches() *schema.Resource {
 	}
 }
 
-// DataSourceJamfProAdvancedMobileDeviceSearchesRead fetches the details of a specific API integration
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'display_name' attribute over the 'id'
-// attribute for fetching details. If neither 'display_name' nor 'id' is provided, it returns an error.
+// DataSourceJamfProAdvancedMobileDeviceSearchesRead fetches the details of a specific advanced mobile device search
+// from Jamf Pro using either its unique Name or its Id. Exactly one of 'id' or 'name' is set; a name is resolved
+// to an ID first, and an error is returned when no object or more than one object has that name.
 // Once the details are fetched, they are set in the data source's state.
 //
 // Parameters:
@@ -54,7 +59,10 @@ func DataSourceJamfProAdvancedMobileDeviceSearchesRead(ctx context.Context, d *s
 
 	// Initialize variables
 	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Advanced Mobile Device Search", "name", listAdvancedMobileDeviceSearches)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Convert resourceID from string to int
 	resourceIDInt, err := strconv.Atoi(resourceID)
@@ -62,15 +70,15 @@ func DataSourceJamfProAdvancedMobileDeviceSearchesRead(ctx context.Context, d *s
 		return diag.FromErr(fmt.Errorf("error converting resource ID '%s' to int: %v", resourceID, err))
 	}
 
-	var resource *jamfpro.ResourceAdvancedUserSearch
+	var resource *jamfpro.ResourceAdvancedMobileDeviceSearch
 
 	// Read operation with retry
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
-		resource, apiErr = conn.GetAdvancedUserSearchByID(resourceIDInt)
+		resource, apiErr = conn.GetAdvancedMobileDeviceSearchByID(resourceIDInt)
 		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+			// Retry transient API errors, stop on permanent ones
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully read the resource, exit the retry loop
 		return nil
@@ -78,14 +86,14 @@ func DataSourceJamfProAdvancedMobileDeviceSearchesRead(ctx context.Context, d *s
 
 	if err != nil {
 		// Handle the final error after all retries have been exhausted
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Advanced User Search with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Advanced Mobile Device Search with ID '%s'", resourceID))
 	}
 
 	// Check if resource data exists and set the Terraform state
 	if resource != nil {
 		d.SetId(resourceID) // Confirm the ID in the Terraform state
 		if err := d.Set("name", resource.Name); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Advanced User Search with ID '%s': %v", resourceID, err))...)
+			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Advanced Mobile Device Search with ID '%s': %v", resourceID, err))...)
 		}
 	} else {
 		d.SetId("") // Data not found, unset the ID in the Terraform state
@@ -0,0 +1,49 @@
+// advancedmobiledevicesearches_list_data_source.go
+package advancedmobiledevicesearches
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAdvancedMobileDeviceSearchesList lists the Jamf Pro advanced mobile device searches, optionally filtered by name or site.
+func DataSourceJamfProAdvancedMobileDeviceSearchesList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Advanced Mobile Device Searches",
+		Lister:     listAdvancedMobileDeviceSearches,
+		SiteGetter: getAdvancedMobileDeviceSearchSiteID,
+	}
+	return list.DataSource()
+}
+
+// listAdvancedMobileDeviceSearches returns the ID and name of every Jamf Pro advanced mobile device search.
+func listAdvancedMobileDeviceSearches(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetAdvancedMobileDeviceSearches()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.AdvancedMobileDeviceSearches))
+	for _, search := range response.AdvancedMobileDeviceSearches {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(search.ID), Name: search.Name})
+	}
+	return items, nil
+}
+
+// getAdvancedMobileDeviceSearchSiteID returns the ID of the site a advanced mobile device search belongs to.
+func getAdvancedMobileDeviceSearchSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetAdvancedMobileDeviceSearchByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.Site), nil
+}
@@ -59,6 +59,9 @@ func constructJamfProAdvancedMobileDeviceSearch(d *schema.ResourceData) (*jamfpr
 			ID:   siteData["id"].(int),
 			Name: siteData["name"].(string),
 		}
+	} else {
+		// Without a site block the object is taken out of any site it is in
+		search.Site = jamfpro.SharedResourceSite{ID: -1, Name: "None"}
 	}
 
 	// Serialize and pretty-print the Advanced Mobile Device Search object as XML for logging
@@ -5,14 +5,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/criteria"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -30,6 +34,17 @@ func ResourceJamfProAdvancedMobileDeviceSearches() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		CustomizeDiff: customdiff.All(
+			criteria.CustomizeDiff(criteria.MobileDevices, nil),
+			defaults.CustomizeDiff(defaults.Field{Key: "site", Kind: defaults.Site, Format: defaults.Block, Unset: []interface{}{}}),
+		),
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetAdvancedMobileDeviceSearchByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -114,6 +129,7 @@ func ResourceJamfProAdvancedMobileDeviceSearches() *schema.Resource {
 			"site": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -158,14 +174,14 @@ func ResourceJamfProAdvancedMobileDeviceSearchCreate(ctx context.Context, d *sch
 		var apiErr error
 		creationResponse, apiErr = conn.CreateAdvancedMobileDeviceSearch(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Advanced Mobile Device Search '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Advanced Mobile Device Search '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -220,19 +236,14 @@ func ResourceJamfProAdvancedMobileDeviceSearchRead(ctx context.Context, d *schem
 		var apiErr error
 		resource, apiErr = conn.GetAdvancedMobileDeviceSearchByID(resourceIDInt)
 		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				// Return non-retryable error with a message to avoid SDK issues
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			// Retry for other types of errors
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	// If err is not nil, check if it's due to the resource being not found
 	if err != nil {
-		if err.Error() == "resource not found, marked for deletion" {
+		if provider_diagnostics.IsNotFound(err) {
 			// Resource not found, remove from Terraform state
 			d.SetId("")
 			// Append a warning diagnostic and return
@@ -245,7 +256,7 @@ func ResourceJamfProAdvancedMobileDeviceSearchRead(ctx context.Context, d *schem
 		}
 
 		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Advanced Mobile Device Search with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Advanced Mobile Device Search with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -304,12 +315,15 @@ func ResourceJamfProAdvancedMobileDeviceSearchRead(ctx context.Context, d *schem
 		}
 	}
 
-	// Handle "site" field
-	site := map[string]interface{}{
-		"id":   resource.Site.ID,
-		"name": resource.Site.Name,
+	// Handle "site" field, which Jamf Pro reports with the ID -1 for objects without a site
+	site := []interface{}{}
+	if resource.Site.ID > 0 {
+		site = append(site, map[string]interface{}{
+			"id":   resource.Site.ID,
+			"name": resource.Site.Name,
+		})
 	}
-	if err := d.Set("site", []interface{}{site}); err != nil {
+	if err := d.Set("site", site); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -346,14 +360,14 @@ func ResourceJamfProAdvancedMobileDeviceSearchUpdate(ctx context.Context, d *sch
 		_, apiErr := conn.UpdateAdvancedMobileDeviceSearchByID(resourceIDInt, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Advanced User Search '%s' (ID: %s) after retries: %v", resource.Name, resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Advanced User Search '%s' (ID: %s)", resource.Name, resourceID))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -393,8 +407,8 @@ func ResourceJamfProAdvancedMobileDeviceSearchDelete(ctx context.Context, d *sch
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteAdvancedMobileDeviceSearchByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -402,7 +416,7 @@ func ResourceJamfProAdvancedMobileDeviceSearchDelete(ctx context.Context, d *sch
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Advanced Mobile Device Search '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Advanced Mobile Device Search '%s' (ID: %s)", d.Get("name").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
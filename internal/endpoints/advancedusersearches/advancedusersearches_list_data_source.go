@@ -0,0 +1,49 @@
+// advancedusersearches_list_data_source.go
+package advancedusersearches
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAdvancedUserSearchesList lists the Jamf Pro advanced user searches, optionally filtered by name or site.
+func DataSourceJamfProAdvancedUserSearchesList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Advanced User Searches",
+		Lister:     listAdvancedUserSearches,
+		SiteGetter: getAdvancedUserSearchSiteID,
+	}
+	return list.DataSource()
+}
+
+// listAdvancedUserSearches returns the ID and name of every Jamf Pro advanced user search.
+func listAdvancedUserSearches(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetAdvancedUserSearches()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.AdvancedUserSearch))
+	for _, search := range response.AdvancedUserSearch {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(search.ID), Name: search.Name})
+	}
+	return items, nil
+}
+
+// getAdvancedUserSearchSiteID returns the ID of the site a advanced user search belongs to.
+func getAdvancedUserSearchSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetAdvancedUserSearchByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.Site), nil
+}
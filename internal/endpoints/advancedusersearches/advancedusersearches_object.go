@@ -55,6 +55,9 @@ func constructJamfProAdvancedUserSearch(d *schema.ResourceData) (*jamfpro.Resour
 			ID:   siteData["id"].(int),
 			Name: siteData["name"].(string),
 		}
+	} else {
+		// Without a site block the object is taken out of any site it is in
+		search.Site = jamfpro.SharedResourceSite{ID: -1, Name: "None"}
 	}
 
 	// Serialize and pretty-print the Advanced User Search object as XML for logging
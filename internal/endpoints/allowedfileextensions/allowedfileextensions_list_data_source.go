@@ -0,0 +1,34 @@
+// allowedfileextensions_list_data_source.go
+package allowedfileextensions
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAllowedFileExtensionsList lists the Jamf Pro allowed file extensions, optionally filtered by name.
+func DataSourceJamfProAllowedFileExtensionsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Allowed File Extensions",
+		Lister: listAllowedFileExtensions,
+	}
+	return list.DataSource()
+}
+
+// listAllowedFileExtensions returns the ID and name of every Jamf Pro allowed file extension.
+func listAllowedFileExtensions(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetAllowedFileExtensions()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.AllowedFileExtensions))
+	for _, extension := range response.AllowedFileExtensions {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(extension.ID), Name: extension.Extension})
+	}
+	return items, nil
+}
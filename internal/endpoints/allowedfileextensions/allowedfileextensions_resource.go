@@ -5,10 +5,11 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,6 +30,13 @@ func ResourceJamfProAllowedFileExtensions() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetAllowedFileExtensionByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -71,14 +79,14 @@ func ResourceJamfProAllowedFileExtensionCreate(ctx context.Context, d *schema.Re
 		var apiErr error
 		creationResponse, apiErr = conn.CreateAllowedFileExtension(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Allowed File Extension '%s' after retries: %v", resource.Extension, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Allowed File Extension '%s'", resource.Extension))
 	}
 
 	// Set the resource ID in Terraform state
@@ -123,19 +131,14 @@ func ResourceJamfProAllowedFileExtensionRead(ctx context.Context, d *schema.Reso
 		var apiErr error
 		resource, apiErr = conn.GetAllowedFileExtensionByID(resourceIDInt)
 		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				// Return non-retryable error with a message to avoid SDK issues
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			// Retry for other types of errors
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	// If err is not nil, check if it's due to the resource being not found
 	if err != nil {
-		if err.Error() == "resource not found, marked for deletion" {
+		if provider_diagnostics.IsNotFound(err) {
 			// Resource not found, remove from Terraform state
 			d.SetId("")
 			// Append a warning diagnostic and return
@@ -148,7 +151,7 @@ func ResourceJamfProAllowedFileExtensionRead(ctx context.Context, d *schema.Reso
 		}
 
 		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Allowed File Extension with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Allowed File Extension with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -207,8 +210,8 @@ func ResourceJamfProAllowedFileExtensionDelete(ctx context.Context, d *schema.Re
 			resourceName := d.Get("extension").(string)
 			apiErrByName := conn.DeleteAllowedFileExtensionByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -216,7 +219,7 @@ func ResourceJamfProAllowedFileExtensionDelete(ctx context.Context, d *schema.Re
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Allowed File Extension '%s' (ID: %s) after retries: %v", d.Get("extension").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Allowed File Extension '%s' (ID: %s)", d.Get("extension").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
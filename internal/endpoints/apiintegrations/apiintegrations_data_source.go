@@ -8,6 +8,8 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -20,12 +22,15 @@ func DataSourceJamfProApiIntegrations() *schema.Resource {
 		ReadContext: dataSourceJamfProApiIntegrationsRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API integration.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "display_name"},
+				Description:  "The unique identifier of the API integration.",
 			},
 			"display_name": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The display name of the API integration.",
 			},
@@ -34,8 +39,8 @@ func DataSourceJamfProApiIntegrations() *schema.Resource {
 }
 
 // dataSourceJamfProApiIntegrationsRead fetches the details of a specific API integration
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'display_name' attribute over the 'id'
-// attribute for fetching details. If neither 'display_name' nor 'id' is provided, it returns an error.
+// from Jamf Pro using either its unique Name or its Id. Exactly one of 'id' or 'display_name' is set; a name is resolved
+// to an ID first, and an error is returned when no object or more than one object has that name.
 // Once the details are fetched, they are set in the data source's state.
 //
 // Parameters:
@@ -55,7 +60,10 @@ func dataSourceJamfProApiIntegrationsRead(ctx context.Context, d *schema.Resourc
 
 	// Initialize variables
 	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro API Integration", "display_name", listApiIntegrations)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Convert resourceID from string to int
 	resourceIDInt, err := strconv.Atoi(resourceID)
@@ -70,8 +78,8 @@ func dataSourceJamfProApiIntegrationsRead(ctx context.Context, d *schema.Resourc
 		var apiErr error
 		resource, apiErr = conn.GetApiIntegrationByID(resourceIDInt)
 		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+			// Retry transient API errors, stop on permanent ones
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully read the resource, exit the retry loop
 		return nil
@@ -79,7 +87,7 @@ func dataSourceJamfProApiIntegrationsRead(ctx context.Context, d *schema.Resourc
 
 	if err != nil {
 		// Handle the final error after all retries have been exhausted
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro API Integration with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro API Integration with ID '%s'", resourceID))
 	}
 
 	// Check if resource data exists and set the Terraform state
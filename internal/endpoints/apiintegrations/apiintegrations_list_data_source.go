@@ -0,0 +1,34 @@
+// apiintegrations_list_data_source.go
+package apiintegrations
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProApiIntegrationsList lists the Jamf Pro API integrations, optionally filtered by name.
+func DataSourceJamfProApiIntegrationsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro API Integrations",
+		Lister: listApiIntegrations,
+	}
+	return list.DataSource()
+}
+
+// listApiIntegrations returns the ID and name of every Jamf Pro API integration.
+func listApiIntegrations(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetApiIntegrations("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, integration := range response.Results {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(integration.ID), Name: integration.DisplayName})
+	}
+	return items, nil
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -19,12 +21,15 @@ func DataSourceJamfProAPIRoles() *schema.Resource {
 		ReadContext: DataSourceJamfProAPIRolesRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API role.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "display_name"},
+				Description:  "The unique identifier of the API role.",
 			},
 			"display_name": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The unique name of the Jamf Pro API role.",
 			},
@@ -33,8 +38,8 @@ func DataSourceJamfProAPIRoles() *schema.Resource {
 }
 
 // DataSourceJamfProAPIRolesRead fetches the details of a specific API role from Jamf Pro using either its unique Name or its Id.
-// The function prioritizes the 'name' attribute over the 'id' attribute for fetching details. If neither 'name' nor 'id' is provided,
-// it returns an error. Once the details are fetched, they are set in the data source's state.
+// Exactly one of 'id' or 'name' is set; a name is resolved to an ID first, and an error is returned when no
+// object or more than one object has that name. Once the details are fetched, they are set in the data source's state.
 func DataSourceJamfProAPIRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Initialize API client
 	apiclient, ok := meta.(*client.APIClient)
@@ -45,17 +50,20 @@ func DataSourceJamfProAPIRolesRead(ctx context.Context, d *schema.ResourceData,
 
 	// Initialize variables
 	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro API Role", "display_name", listAPIRoles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	var resource *jamfpro.ResourceAPIRole
 
 	// Read operation with retry
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
 		resource, apiErr = conn.GetJamfApiRoleByID(resourceID)
 		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+			// Retry transient API errors, stop on permanent ones
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully read the resource, exit the retry loop
 		return nil
@@ -63,7 +71,7 @@ func DataSourceJamfProAPIRolesRead(ctx context.Context, d *schema.ResourceData,
 
 	if err != nil {
 		// Handle the final error after all retries have been exhausted
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro API Role with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro API Role with ID '%s'", resourceID))
 	}
 
 	// Check if resource data exists and set the Terraform state
@@ -0,0 +1,32 @@
+// apiroles_list_data_source.go
+package apiroles
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAPIRolesList lists the Jamf Pro API roles, optionally filtered by name.
+func DataSourceJamfProAPIRolesList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro API Roles",
+		Lister: listAPIRoles,
+	}
+	return list.DataSource()
+}
+
+// listAPIRoles returns the ID and name of every Jamf Pro API role.
+func listAPIRoles(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetJamfAPIRoles("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, role := range response.Results {
+		items = append(items, crud.ListItem{ID: role.ID, Name: role.DisplayName})
+	}
+	return items, nil
+}
@@ -4,11 +4,12 @@ package apiroles
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -28,6 +29,13 @@ func ResourceJamfProAPIRoles() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetJamfApiRoleByName(name)
+			if err != nil {
+				return "", err
+			}
+			return resource.ID, nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -83,14 +91,14 @@ func ResourceJamfProAPIRolesCreate(ctx context.Context, d *schema.ResourceData,
 		var apiErr error
 		creationResponse, apiErr = conn.CreateJamfApiRole(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro API Role '%s' after retries: %v", resource.DisplayName, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro API Role '%s'", resource.DisplayName))
 	}
 
 	// Set the resource ID in Terraform state
@@ -129,19 +137,14 @@ func ResourceJamfProAPIRolesRead(ctx context.Context, d *schema.ResourceData, me
 		var apiErr error
 		resource, apiErr = conn.GetJamfApiRoleByID(resourceID)
 		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				// Return non-retryable error with a message to avoid SDK issues
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			// Retry for other types of errors
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	// If err is not nil, check if it's due to the resource being not found
 	if err != nil {
-		if err.Error() == "resource not found, marked for deletion" {
+		if provider_diagnostics.IsNotFound(err) {
 			// Resource not found, remove from Terraform state
 			d.SetId("")
 			// Append a warning diagnostic and return
@@ -154,7 +157,7 @@ func ResourceJamfProAPIRolesRead(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Api Role with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Api Role with ID '%s'", resourceID))
 	}
 
 	// Map the configuration fields from the API response to a structured map
@@ -202,14 +205,14 @@ func ResourceJamfProAPIRolesUpdate(ctx context.Context, d *schema.ResourceData,
 		_, apiErr := conn.UpdateJamfApiRoleByID(resourceID, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro API Role '%s' (ID: %s) after retries: %v", resource.DisplayName, resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro API Role '%s' (ID: %s)", resource.DisplayName, resourceID))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -243,8 +246,8 @@ func ResourceJamfProAPIRolesDelete(ctx context.Context, d *schema.ResourceData,
 			resourceName := d.Get("display_name").(string)
 			apiErrByName := conn.DeleteJamfApiRoleByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -252,7 +255,7 @@ func ResourceJamfProAPIRolesDelete(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro API role '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro API role '%s' (ID: %s)", d.Get("name").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -0,0 +1,32 @@
+// buildings_list_data_source.go
+package buildings
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProBuildingsList lists the Jamf Pro buildings, optionally filtered by name.
+func DataSourceJamfProBuildingsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Buildings",
+		Lister: listBuildings,
+	}
+	return list.DataSource()
+}
+
+// listBuildings returns the ID and name of every Jamf Pro building.
+func listBuildings(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetBuildings("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, building := range response.Results {
+		items = append(items, crud.ListItem{ID: building.ID, Name: building.Name})
+	}
+	return items, nil
+}
@@ -2,32 +2,62 @@
 package buildings
 
 import (
-	"context"
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
-	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// building wires the Jamf Pro Building API calls into the shared CRUD implementation.
+var building = &crud.Resource[jamfpro.ResourceBuilding]{
+	Name:      "Jamf Pro Building",
+	Construct: constructJamfProBuilding,
+	State:     updateTerraformState,
+	Creator: func(conn *jamfpro.Client, resource *jamfpro.ResourceBuilding) (string, error) {
+		response, err := conn.CreateBuilding(resource)
+		if err != nil {
+			return "", err
+		}
+		return response.ID, nil
+	},
+	Getter: func(conn *jamfpro.Client, id string) (*jamfpro.ResourceBuilding, error) {
+		return conn.GetBuildingByID(id)
+	},
+	Updater: func(conn *jamfpro.Client, id string, resource *jamfpro.ResourceBuilding) error {
+		_, err := conn.UpdateBuildingByID(id, resource)
+		return err
+	},
+	Deleter: func(conn *jamfpro.Client, id string) error {
+		return conn.DeleteBuildingByID(id)
+	},
+	DeleterByName: func(conn *jamfpro.Client, name string) error {
+		return conn.DeleteBuildingByName(name)
+	},
+}
+
 // ResourceJamfProBuildings defines the schema and CRUD operations for managing buildings in Terraform.
 func ResourceJamfProBuildings() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: ResourceJamfProBuildingCreate,
-		ReadContext:   ResourceJamfProBuildingRead,
-		UpdateContext: ResourceJamfProBuildingUpdate,
-		DeleteContext: ResourceJamfProBuildingDelete,
+		CreateContext: building.Create,
+		ReadContext:   building.Read,
+		UpdateContext: building.Update,
+		DeleteContext: building.Delete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 			Read:   schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetBuildingByName(name)
+			if err != nil {
+				return "", err
+			}
+			return resource.ID, nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -73,109 +103,10 @@ func ResourceJamfProBuildings() *schema.Resource {
 	}
 }
 
-// ResourceJamfProBuildingCreate is responsible for creating a new Building in the remote system.
-// The function:
-// 1. Constructs the building data using the provided Terraform configuration.
-// 2. Calls the API to create the building in Jamf Pro.
-// 3. Updates the Terraform state with the ID of the newly created building.
-// 4. Initiates a read operation to synchronize the Terraform state with the actual state in Jamf Pro.
-func ResourceJamfProBuildingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Assert the meta interface to the expected APIClient type
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
+// updateTerraformState updates the Terraform state with the Jamf Pro Building returned by the API.
+func updateTerraformState(d *schema.ResourceData, resource *jamfpro.ResourceBuilding) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// Construct the resource object
-	resource, err := constructJamfProBuilding(d)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Buildings: %v", err))
-	}
-
-	// Retry the API call to create the resource in Jamf Pro
-	var creationResponse *jamfpro.ResponseBuildingCreate
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		var apiErr error
-		creationResponse, apiErr = conn.CreateBuilding(resource)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		// No error, exit the retry loop
-		return nil
-	})
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Building '%s' after retries: %v", resource.Name, err))
-	}
-
-	// Set the resource ID in Terraform state
-	d.SetId(creationResponse.ID)
-
-	// Read the resource to ensure the Terraform state is up to date
-	readDiags := ResourceJamfProBuildingRead(ctx, d, meta)
-	if len(readDiags) > 0 {
-		diags = append(diags, readDiags...)
-	}
-
-	return diags
-}
-
-// ResourceJamfProBuildingRead is responsible for reading the current state of a Building Resource from the remote system.
-// The function:
-// 1. Fetches the building's current state using its ID. If it fails, then obtain the building's current state using its Name.
-// 2. Updates the Terraform state with the fetched data to ensure it accurately reflects the current state in Jamf Pro.
-// 3. Handles any discrepancies, such as the building being deleted outside of Terraform, to keep the Terraform state synchronized.
-func ResourceJamfProBuildingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Initialize API client
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
-	var diags diag.Diagnostics
-	resourceID := d.Id()
-
-	var resource *jamfpro.ResourceBuilding
-
-	// Read operation with retry
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
-		var apiErr error
-		resource, apiErr = conn.GetBuildingByID(resourceID)
-		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				// Return non-retryable error with a message to avoid SDK issues
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			// Retry for other types of errors
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
-
-	// If err is not nil, check if it's due to the resource being not found
-	if err != nil {
-		if err.Error() == "resource not found, marked for deletion" {
-			// Resource not found, remove from Terraform state
-			d.SetId("")
-			// Append a warning diagnostic and return
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "Resource not found",
-				Detail:   fmt.Sprintf("Jamf Pro Buildings with ID '%s' was not found on the server and is marked for deletion from terraform state.", resourceID),
-			})
-			return diags
-		}
-
-		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Buildings with ID '%s' after retries: %v", resourceID, err))
-	}
-
 	// Map the configuration fields from the API response to a structured map
 	buildingData := map[string]interface{}{
 		"name":            resource.Name,
@@ -196,86 +127,3 @@ func ResourceJamfProBuildingRead(ctx context.Context, d *schema.ResourceData, me
 
 	return diags
 }
-
-// ResourceJamfProBuildingUpdate is responsible for updating an existing Building on the remote system.
-func ResourceJamfProBuildingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Initialize API client
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
-	var diags diag.Diagnostics
-	resourceID := d.Id()
-
-	// Construct the resource object
-	resource, err := constructJamfProBuilding(d)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Building for update: %v", err))
-	}
-
-	// Update operations with retries
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, apiErr := conn.UpdateBuildingByID(resourceID, resource)
-		if apiErr != nil {
-			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
-		}
-		// Successfully updated the resource, exit the retry loop
-		return nil
-	})
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Building '%s' (ID: %s) after retries: %v", resource.Name, resourceID, err))
-	}
-
-	// Read the resource to ensure the Terraform state is up to date
-	readDiags := ResourceJamfProBuildingRead(ctx, d, meta)
-	if len(readDiags) > 0 {
-		diags = append(diags, readDiags...)
-	}
-
-	return diags
-}
-
-// ResourceJamfProBuildingDelete is responsible for deleting a Building.
-func ResourceJamfProBuildingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Initialize API client
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
-	var diags diag.Diagnostics
-	resourceID := d.Id()
-
-	// Use the retry function for the delete operation with appropriate timeout
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
-		// Attempt to delete by ID
-		apiErr := conn.DeleteBuildingByID(resourceID)
-		if apiErr != nil {
-			// If deleting by ID fails, attempt to delete by Name
-			resourceName := d.Get("name").(string)
-			apiErrByName := conn.DeleteBuildingByName(resourceName)
-			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
-			}
-		}
-		// Successfully deleted the resource, exit the retry loop
-		return nil
-	})
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Building '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
-	}
-
-	// Clear the ID from the Terraform state as the resource has been deleted
-	d.SetId("")
-
-	return diags
-}
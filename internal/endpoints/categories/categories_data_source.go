@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,12 +19,15 @@ func DataSourceJamfProCategories() *schema.Resource {
 		ReadContext: DataSourceJamfProCategoriesRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the Category.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the Category.",
 			},
 			"name": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The unique name of the jamf pro Category.",
 			},
@@ -30,7 +35,7 @@ func DataSourceJamfProCategories() *schema.Resource {
 	}
 }
 
-// DataSourceJamfProCategoriesRead fetches the details of a specific category from Jamf Pro using its unique ID.
+// DataSourceJamfProCategoriesRead fetches the details of a specific category from Jamf Pro using its unique ID or name.
 func DataSourceJamfProCategoriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Initialize API client
 	apiclient, ok := meta.(*client.APIClient)
@@ -43,12 +48,15 @@ func DataSourceJamfProCategoriesRead(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	// Get the Category ID from the data source's arguments
-	resourceID := d.Get("id").(string)
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Category", "name", listCategories)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Attempt to fetch the Category's details using its ID
 	Category, err := conn.GetCategoryByID(resourceID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Category with ID '%s': %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Category with ID '%s'", resourceID))
 	}
 
 	// Check if resource data exists and set the Terraform state
@@ -0,0 +1,32 @@
+// categories_list_data_source.go
+package categories
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProCategoriesList lists the Jamf Pro categories, optionally filtered by name.
+func DataSourceJamfProCategoriesList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Categories",
+		Lister: listCategories,
+	}
+	return list.DataSource()
+}
+
+// listCategories returns the ID and name of every Jamf Pro category.
+func listCategories(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetCategories("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, category := range response.Results {
+		items = append(items, crud.ListItem{ID: category.Id, Name: category.Name})
+	}
+	return items, nil
+}
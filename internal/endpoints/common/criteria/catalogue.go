@@ -0,0 +1,306 @@
+// catalogue.go
+package criteria
+
+import "strings"
+
+// fieldType is the kind of value a criterion compares, which determines the search types Jamf Pro
+// accepts for it.
+type fieldType string
+
+const (
+	fieldText       fieldType = "text"
+	fieldNumber     fieldType = "number"
+	fieldVersion    fieldType = "version"
+	fieldDate       fieldType = "date"
+	fieldGroup      fieldType = "group"
+	fieldCollection fieldType = "collection"
+	fieldChoice     fieldType = "choice"
+)
+
+var (
+	textSearchTypes       = []string{"is", "is not", "like", "not like", "matches regex", "does not match regex"}
+	comparisonSearchTypes = []string{"more than", "less than", "greater than", "greater than or equal", "less than or equal"}
+	dateSearchTypes       = []string{"is", "is not", "like", "not like", "before (yyyy-mm-dd)", "after (yyyy-mm-dd)", "more than x days ago", "less than x days ago"}
+)
+
+// searchTypes are the search types Jamf Pro accepts for each field type.
+var searchTypes = map[fieldType][]string{
+	fieldText:       textSearchTypes,
+	fieldNumber:     append(append([]string{}, textSearchTypes...), comparisonSearchTypes...),
+	fieldVersion:    append(append([]string{}, textSearchTypes...), comparisonSearchTypes...),
+	fieldDate:       dateSearchTypes,
+	fieldGroup:      {"member of", "not member of"},
+	fieldCollection: append([]string{"has", "does not have"}, textSearchTypes...),
+	fieldChoice:     {"is", "is not"},
+}
+
+// extensionAttributeFieldTypes maps the data types of extension attributes to field types.
+var extensionAttributeFieldTypes = map[string]fieldType{
+	"string":  fieldText,
+	"integer": fieldNumber,
+	"date":    fieldDate,
+}
+
+// dynamicPrefixes are the prefixes of criteria Jamf Pro names after other objects, such as
+// "Patch Reporting: Google Chrome", with the field type of each.
+var dynamicPrefixes = map[string]fieldType{
+	"Patch Reporting: ": fieldVersion,
+}
+
+// builtInFieldType returns the field type of a criterion that is built into Jamf Pro.
+func builtInFieldType(catalogue map[string]fieldType, name string) (fieldType, bool) {
+	if t, ok := catalogue[name]; ok {
+		return t, true
+	}
+	for prefix, t := range dynamicPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// locationCriteria are the user and location criteria shared by computers and mobile devices.
+var locationCriteria = map[string]fieldType{
+	"Username":      fieldText,
+	"Full Name":     fieldText,
+	"Email Address": fieldText,
+	"Phone Number":  fieldText,
+	"Position":      fieldText,
+	"Department":    fieldText,
+	"Building":      fieldText,
+	"Room":          fieldText,
+	"Site":          fieldText,
+}
+
+// purchasingCriteria are the purchasing criteria shared by computers and mobile devices.
+var purchasingCriteria = map[string]fieldType{
+	"Asset Tag":                              fieldText,
+	"Bar Code":                               fieldText,
+	"PO Number":                              fieldText,
+	"PO Date":                                fieldDate,
+	"Vendor":                                 fieldText,
+	"Purchase Price":                         fieldText,
+	"Purchasing Account":                     fieldText,
+	"Purchasing Contact":                     fieldText,
+	"AppleCare ID":                           fieldText,
+	"Life Expectancy":                        fieldNumber,
+	"Warranty Expiration":                    fieldDate,
+	"Lease Expiration":                       fieldDate,
+	"Leased":                                 fieldChoice,
+	"Purchased":                              fieldChoice,
+	"Enrollment Method: PreStage enrollment": fieldText,
+}
+
+// computerCriteria are the criteria built into Jamf Pro for smart computer groups and advanced
+// computer searches.
+var computerCriteria = merge(locationCriteria, purchasingCriteria, map[string]fieldType{
+	"Computer Name":            fieldText,
+	"Serial Number":            fieldText,
+	"UDID":                     fieldText,
+	"IP Address":               fieldText,
+	"Last Reported IP Address": fieldText,
+	"MAC Address":              fieldText,
+	"Alternate MAC Address":    fieldText,
+	"Model":                    fieldText,
+	"Model Identifier":         fieldText,
+	"Make":                     fieldText,
+	"Platform":                 fieldText,
+	"Architecture Type":        fieldText,
+	"Processor Type":           fieldText,
+	"Processor Speed MHz":      fieldNumber,
+	"Number of Processors":     fieldNumber,
+	"Number of Cores":          fieldNumber,
+	"Total Number of Cores":    fieldNumber,
+	"Bus Speed MHz":            fieldNumber,
+	"Total RAM MB":             fieldNumber,
+	"Battery Capacity":         fieldNumber,
+	"Boot ROM":                 fieldText,
+	"SMC Version":              fieldText,
+	"NIC Speed":                fieldText,
+	"Optical Drive":            fieldText,
+	"Operating System":         fieldText,
+	"Operating System Name":    fieldText,
+	"Operating System Version": fieldVersion,
+	"Operating System Build":   fieldText,
+	"Operating System Rapid Security Response": fieldText,
+	"Jamf Binary Version":                      fieldVersion,
+	"Managed":                                  fieldChoice,
+	"Supervised":                               fieldChoice,
+	"MDM Capability":                           fieldChoice,
+	"User Approved MDM":                        fieldChoice,
+	"Enrolled via Automated Device Enrollment": fieldChoice,
+	"Last Check-in":                            fieldDate,
+	"Last Inventory Update":                    fieldDate,
+	"Last Enrollment":                          fieldDate,
+	"Computer Group":                           fieldGroup,
+	"Application Title":                        fieldCollection,
+	"Application Version":                      fieldVersion,
+	"Application Bundle ID":                    fieldCollection,
+	"Mac App Store Applications Title":         fieldCollection,
+	"Mac App Store Applications Version":       fieldVersion,
+	"Plug-in Title":                            fieldCollection,
+	"Font Title":                               fieldCollection,
+	"Running Services":                         fieldCollection,
+	"Cached Packages":                          fieldCollection,
+	"Packages Installed By Casper":             fieldCollection,
+	"Packages Installed By Installer.app/SWU":  fieldCollection,
+	"Available SWUs":                           fieldCollection,
+	"Available Software Updates":               fieldCollection,
+	"Number of Available Updates":              fieldNumber,
+	"Licensed Software":                        fieldCollection,
+	"Profile Name":                             fieldCollection,
+	"Profile Identifier":                       fieldCollection,
+	"Configuration Profile":                    fieldCollection,
+	"Certificate Name":                         fieldCollection,
+	"Certificate Expiration Date":              fieldDate,
+	"Local User Accounts":                      fieldCollection,
+	"Home Directory Size MB":                   fieldNumber,
+	"Printer":                                  fieldCollection,
+	"Drive Capacity MB":                        fieldNumber,
+	"Boot Drive Percentage Full":               fieldNumber,
+	"Boot Drive Available MB":                  fieldNumber,
+	"Disk Encryption Configuration":            fieldText,
+	"FileVault 2 Status":                       fieldText,
+	"FileVault 2 Partition Encryption State":   fieldText,
+	"FileVault 2 Individual Key Validation":    fieldText,
+	"FileVault 2 Institutional Key":            fieldText,
+	"FileVault 2 Eligibility":                  fieldText,
+	"FileVault 2 Enabled User":                 fieldText,
+	"FileVault Status":                         fieldText,
+	"Recovery Lock Enabled":                    fieldChoice,
+	"Gatekeeper":                               fieldText,
+	"System Integrity Protection":              fieldText,
+	"XProtect Definitions Version":             fieldVersion,
+	"Firewall Enabled":                         fieldChoice,
+	"Activation Lock Enabled":                  fieldChoice,
+	"Secure Boot Level":                        fieldText,
+	"External Boot Level":                      fieldText,
+	"Bootstrap Token Allowed":                  fieldChoice,
+	"Bootstrap Token Escrowed":                 fieldChoice,
+	"Active Directory Status":                  fieldText,
+	"Master Password Set":                      fieldChoice,
+	"Remote Desktop Enabled":                   fieldChoice,
+	"Apple Silicon":                            fieldChoice,
+	"Last iCloud Backup":                       fieldDate,
+	"iCloud Account":                           fieldText,
+	"Declarative Device Management Enabled":    fieldChoice,
+	"Software Update Device ID":                fieldText,
+})
+
+// mobileDeviceCriteria are the criteria built into Jamf Pro for smart mobile device groups and
+// advanced mobile device searches.
+var mobileDeviceCriteria = merge(locationCriteria, purchasingCriteria, map[string]fieldType{
+	"Display Name":                     fieldText,
+	"Device Name":                      fieldText,
+	"Serial Number":                    fieldText,
+	"UDID":                             fieldText,
+	"Model":                            fieldText,
+	"Model Identifier":                 fieldText,
+	"Model Number":                     fieldText,
+	"Device Type":                      fieldText,
+	"iOS Version":                      fieldVersion,
+	"iOS Build":                        fieldText,
+	"OS Version":                       fieldVersion,
+	"OS Build":                         fieldText,
+	"OS Rapid Security Response":       fieldText,
+	"Wi-Fi MAC Address":                fieldText,
+	"Bluetooth MAC Address":            fieldText,
+	"IP Address":                       fieldText,
+	"Modem Firmware Version":           fieldText,
+	"ICCID":                            fieldText,
+	"IMEI":                             fieldText,
+	"MEID":                             fieldText,
+	"Carrier Settings Version":         fieldText,
+	"Current Carrier Network":          fieldText,
+	"Home Carrier Network":             fieldText,
+	"Cellular Technology":              fieldText,
+	"Data Roaming Enabled":             fieldChoice,
+	"Voice Roaming Enabled":            fieldChoice,
+	"Roaming":                          fieldChoice,
+	"Personal Hotspot Enabled":         fieldChoice,
+	"Managed":                          fieldChoice,
+	"Supervised":                       fieldChoice,
+	"Shared iPad":                      fieldChoice,
+	"Tethered":                         fieldChoice,
+	"Device Ownership Type":            fieldText,
+	"Device Locator Service Enabled":   fieldChoice,
+	"Do Not Disturb Enabled":           fieldChoice,
+	"Activation Lock Enabled":          fieldChoice,
+	"Lost Mode Enabled":                fieldChoice,
+	"Jailbreak Detected":               fieldChoice,
+	"Passcode Status":                  fieldText,
+	"Passcode Compliance":              fieldText,
+	"Passcode Compliance with Profile": fieldText,
+	"Data Protection":                  fieldChoice,
+	"Hardware Encryption":              fieldText,
+	"Block Level Encryption Capable":   fieldChoice,
+	"File Level Encryption Capable":    fieldChoice,
+	"Battery Level":                    fieldNumber,
+	"Capacity MB":                      fieldNumber,
+	"Available Space MB":               fieldNumber,
+	"Percentage Used":                  fieldNumber,
+	"Languages":                        fieldText,
+	"Locales":                          fieldText,
+	"Time Zone":                        fieldText,
+	"Last Inventory Update":            fieldDate,
+	"Last Enrollment":                  fieldDate,
+	"Last Backup":                      fieldDate,
+	"Last iCloud Backup":               fieldDate,
+	"iCloud Backup Enabled":            fieldChoice,
+	"Mobile Device Group":              fieldGroup,
+	"App Name":                         fieldCollection,
+	"App Version":                      fieldVersion,
+	"App Identifier":                   fieldCollection,
+	"App Short Version":                fieldVersion,
+	"Application Title":                fieldCollection,
+	"Application Version":              fieldVersion,
+	"Application Identifier":           fieldCollection,
+	"Profile Name":                     fieldCollection,
+	"Profile Identifier":               fieldCollection,
+	"Configuration Profile":            fieldCollection,
+	"Certificate Name":                 fieldCollection,
+	"Certificate Expiration Date":      fieldDate,
+	"Provisioning Profile Name":        fieldCollection,
+	"Managed Apple ID":                 fieldText,
+	"Bluetooth Low Energy Capable":     fieldChoice,
+	"Diagnostic and Usage Reporting":   fieldChoice,
+	"App Analytics Enabled":            fieldChoice,
+	"Enrolled via Automated Device Enrollment": fieldChoice,
+	"Declarative Device Management Enabled":    fieldChoice,
+	"Software Update Device ID":                fieldText,
+})
+
+// userCriteria are the criteria built into Jamf Pro for smart user groups and advanced user searches.
+var userCriteria = map[string]fieldType{
+	"Username":                    fieldText,
+	"Full Name":                   fieldText,
+	"Email Address":               fieldText,
+	"Phone Number":                fieldText,
+	"Position":                    fieldText,
+	"LDAP Server":                 fieldText,
+	"Site":                        fieldText,
+	"Managed Apple ID":            fieldText,
+	"Roster Name":                 fieldText,
+	"Roster Source":               fieldText,
+	"Roster Passcode Type":        fieldText,
+	"Roster Unique ID":            fieldText,
+	"Roster Grade":                fieldText,
+	"User Group":                  fieldGroup,
+	"Computer Name":               fieldCollection,
+	"Computer Serial Number":      fieldCollection,
+	"Mobile Device Name":          fieldCollection,
+	"Mobile Device Serial Number": fieldCollection,
+	"VPP Assignment Name":         fieldCollection,
+}
+
+// merge returns the union of catalogues, with later catalogues taking precedence.
+func merge(catalogues ...map[string]fieldType) map[string]fieldType {
+	merged := map[string]fieldType{}
+	for _, catalogue := range catalogues {
+		for name, t := range catalogue {
+			merged[name] = t
+		}
+	}
+	return merged
+}
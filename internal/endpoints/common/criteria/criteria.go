@@ -0,0 +1,265 @@
+// criteria.go
+package criteria
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Subject is the kind of inventory record that smart group and advanced search criteria match.
+type Subject struct {
+	name                string
+	builtIn             map[string]fieldType
+	extensionAttributes *extensionAttributes
+}
+
+var (
+	// Computers are matched by smart computer groups and advanced computer searches.
+	Computers = &Subject{name: "computer", builtIn: computerCriteria, extensionAttributes: &computerExtensionAttributes}
+	// MobileDevices are matched by smart mobile device groups and advanced mobile device searches.
+	MobileDevices = &Subject{name: "mobile device", builtIn: mobileDeviceCriteria, extensionAttributes: &mobileDeviceExtensionAttributes}
+	// Users are matched by smart user groups and advanced user searches.
+	Users = &Subject{name: "user", builtIn: userCriteria, extensionAttributes: &userExtensionAttributes}
+)
+
+// Criterion is a criterion of a smart group or advanced search as configured. Known is false when
+// its name or search type are not known until apply.
+type Criterion struct {
+	Name         string
+	AndOr        string
+	SearchType   string
+	OpeningParen bool
+	ClosingParen bool
+	Known        bool
+}
+
+// CustomizeDiff returns a CustomizeDiffFunc that validates the 'criteria' blocks of a resource
+// against subject with Validate. When applies is set, the criteria are only validated when it
+// returns true, such as for smart groups.
+func CustomizeDiff(subject *Subject, applies func(diff *schema.ResourceDiff) bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if applies != nil && !applies(diff) {
+			return nil
+		}
+		if !diff.NewValueKnown("criteria") {
+			return nil
+		}
+
+		var criteria []Criterion
+		for i, v := range diff.Get("criteria").([]interface{}) {
+			c, _ := v.(map[string]interface{})
+			criterion := Criterion{Known: true}
+			criterion.Name, _ = c["name"].(string)
+			criterion.AndOr, _ = c["and_or"].(string)
+			criterion.SearchType, _ = c["search_type"].(string)
+			criterion.OpeningParen, _ = c["opening_paren"].(bool)
+			criterion.ClosingParen, _ = c["closing_paren"].(bool)
+			for _, key := range []string{"name", "and_or", "search_type", "opening_paren", "closing_paren"} {
+				if !diff.NewValueKnown(fmt.Sprintf("criteria.%d.%s", i, key)) {
+					criterion.Known = false
+				}
+			}
+			criteria = append(criteria, criterion)
+		}
+
+		var conn *jamfpro.Client
+		if apiclient, ok := meta.(*client.APIClient); ok {
+			conn = apiclient.Conn
+		}
+		warnings, err := Validate(subject, criteria, conn)
+		for _, warning := range warnings {
+			log.Printf("[WARN] %s", warning)
+		}
+		return err
+	}
+}
+
+// Validate checks that the opening and closing parentheses of criteria are balanced and that the
+// first criterion is not joined with 'or', returning every such problem together as an error.
+//
+// It also checks that each criterion names a built-in criterion of subject, or one of its extension
+// attributes in the tenant conn connects to, with a search type that Jamf Pro accepts for it.
+// Extension attributes are not checked when conn is nil. As the built-in criteria and search types
+// are not exhaustive, these problems are returned as warnings and the criteria are left to Jamf Pro.
+func Validate(subject *Subject, criteria []Criterion, conn *jamfpro.Client) ([]string, error) {
+	var errs []error
+	depth := 0
+	for i, criterion := range criteria {
+		if !criterion.Known {
+			// Parentheses can no longer be checked once one of them is unknown
+			depth = -1
+			continue
+		}
+
+		if i == 0 && criterion.AndOr == "or" {
+			errs = append(errs, fmt.Errorf("'and_or' for 'criteria' at index 0 must be 'and', as the first criterion is not joined to another"))
+		}
+
+		if depth >= 0 {
+			if criterion.OpeningParen {
+				depth++
+			}
+			if criterion.ClosingParen {
+				if depth == 0 {
+					errs = append(errs, fmt.Errorf("'closing_paren' for 'criteria' at index %d has no matching 'opening_paren'", i))
+				} else {
+					depth--
+				}
+			}
+		}
+	}
+	if depth > 0 {
+		errs = append(errs, fmt.Errorf("'criteria' have %d 'opening_paren' without a matching 'closing_paren'", depth))
+	}
+
+	var warnings []string
+	catalogue := newCatalogue(subject, conn)
+	for i, criterion := range criteria {
+		if !criterion.Known || criterion.Name == "" {
+			continue
+		}
+
+		t, warning := catalogue.fieldType(criterion.Name)
+		if warning != "" {
+			warnings = append(warnings, fmt.Sprintf("'name' for 'criteria' at index %d: %s", i, warning))
+		}
+		if t == "" {
+			continue
+		}
+
+		if allowed := searchTypes[t]; criterion.SearchType != "" && !containsFold(allowed, criterion.SearchType) {
+			warnings = append(warnings, fmt.Sprintf("'search_type' for 'criteria' at index %d: '%s' is not a known search type for the %s criterion '%s', which are '%s'",
+				i, criterion.SearchType, t, criterion.Name, strings.Join(allowed, "', '")))
+		}
+	}
+
+	return warnings, errors.Join(errs...)
+}
+
+// catalogue resolves criterion names to field types for a subject, looking up extension attributes
+// in the tenant as needed.
+type catalogue struct {
+	subject   *Subject
+	conn      *jamfpro.Client
+	refreshed bool
+}
+
+func newCatalogue(subject *Subject, conn *jamfpro.Client) *catalogue {
+	return &catalogue{subject: subject, conn: conn}
+}
+
+// fieldType returns the field type of the criterion name, or an empty field type when it is not
+// known, with a warning when name is not spelled as a known criterion.
+func (c *catalogue) fieldType(name string) (fieldType, string) {
+	if t, ok := builtInFieldType(c.subject.builtIn, name); ok {
+		return t, ""
+	}
+
+	var extensionAttributeNames []string
+	if c.conn != nil {
+		ids, err := cache.names(c.subject.extensionAttributes, c.conn, false)
+		if err == nil {
+			if _, ok := ids[name]; !ok && !c.refreshed {
+				// The extension attribute may have been created since they were listed
+				c.refreshed = true
+				ids, err = cache.names(c.subject.extensionAttributes, c.conn, true)
+			}
+		}
+		if err != nil {
+			log.Printf("[WARN] Could not list the %s extension attributes to validate criterion '%s': %v", c.subject.name, name, err)
+			return "", ""
+		}
+
+		if id, ok := ids[name]; ok {
+			t, err := cache.fieldType(c.subject.extensionAttributes, c.conn, id)
+			if err != nil {
+				log.Printf("[WARN] Could not read the %s extension attribute '%s' to validate its search type: %v", c.subject.name, name, err)
+				return "", ""
+			}
+			return t, ""
+		}
+		for extensionAttributeName := range ids {
+			extensionAttributeNames = append(extensionAttributeNames, extensionAttributeName)
+		}
+	}
+
+	suggestion := closestName(name, c.subject.builtIn, extensionAttributeNames)
+	switch {
+	case suggestion == "":
+		return "", fmt.Sprintf("'%s' is not a known %s criterion or extension attribute and is not validated", name, c.subject.name)
+	case strings.EqualFold(suggestion, name):
+		// Only the case differs, so the criterion is checked as the one it names
+		t, _ := c.fieldType(suggestion)
+		return t, fmt.Sprintf("'%s' is spelled '%s' in Jamf Pro", name, suggestion)
+	}
+	return "", fmt.Sprintf("'%s' is not a known %s criterion or extension attribute; did you mean '%s'?", name, c.subject.name, suggestion)
+}
+
+// closestName returns the built-in criterion or extension attribute name closest to name, when it
+// is close enough for name to be a typo of it.
+func closestName(name string, builtIn map[string]fieldType, extensionAttributeNames []string) string {
+	candidates := append([]string{}, extensionAttributeNames...)
+	for candidate := range builtIn {
+		candidates = append(candidates, candidate)
+	}
+	sort.Strings(candidates)
+
+	threshold := len(name) / 5
+	if threshold < 1 {
+		threshold = 1
+	}
+	if threshold > 3 {
+		threshold = 3
+	}
+
+	best, bestDistance := "", threshold+1
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate, name) {
+			return candidate
+		}
+		if d := distance(strings.ToLower(name), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// distance returns the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
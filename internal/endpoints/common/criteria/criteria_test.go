@@ -0,0 +1,226 @@
+// criteria_test.go
+package criteria
+
+import (
+	"strings"
+	"testing"
+)
+
+// criterion returns a known criterion named name with search type searchType.
+func criterion(name, searchType string) Criterion {
+	return Criterion{Name: name, AndOr: "and", SearchType: searchType, Known: true}
+}
+
+func TestValidateStructure(t *testing.T) {
+	paren := func(c Criterion, opening, closing bool) Criterion {
+		c.OpeningParen, c.ClosingParen = opening, closing
+		return c
+	}
+	or := func(c Criterion) Criterion {
+		c.AndOr = "or"
+		return c
+	}
+	unknown := func(c Criterion) Criterion {
+		c.Known = false
+		return c
+	}
+	name := criterion("Computer Name", "is")
+
+	tests := []struct {
+		name     string
+		criteria []Criterion
+		wantErrs []string
+	}{
+		{
+			name:     "no criteria",
+			criteria: nil,
+		},
+		{
+			name:     "balanced parentheses",
+			criteria: []Criterion{paren(name, true, false), or(name), paren(name, false, true), paren(name, true, true)},
+		},
+		{
+			name:     "nested parentheses",
+			criteria: []Criterion{paren(name, true, false), paren(name, true, false), paren(name, false, true), paren(name, false, true)},
+		},
+		{
+			name:     "closing parenthesis without an opening one",
+			criteria: []Criterion{name, paren(name, false, true)},
+			wantErrs: []string{"'closing_paren' for 'criteria' at index 1 has no matching 'opening_paren'"},
+		},
+		{
+			name:     "closing before opening",
+			criteria: []Criterion{paren(name, false, true), paren(name, true, false)},
+			wantErrs: []string{"at index 0 has no matching 'opening_paren'", "have 1 'opening_paren' without a matching 'closing_paren'"},
+		},
+		{
+			name:     "unclosed parentheses",
+			criteria: []Criterion{paren(name, true, false), paren(name, true, false), paren(name, false, true)},
+			wantErrs: []string{"have 1 'opening_paren' without a matching 'closing_paren'"},
+		},
+		{
+			name:     "unknown criterion stops the parentheses check",
+			criteria: []Criterion{paren(name, true, false), unknown(name)},
+		},
+		{
+			name:     "first criterion joined with or",
+			criteria: []Criterion{or(name), name},
+			wantErrs: []string{"'and_or' for 'criteria' at index 0 must be 'and'"},
+		},
+		{
+			name:     "later criterion joined with or",
+			criteria: []Criterion{name, or(name)},
+		},
+		{
+			name:     "unknown first criterion",
+			criteria: []Criterion{unknown(or(name)), name},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Validate(Computers, tc.criteria, nil)
+			if len(tc.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected errors containing %q", tc.wantErrs)
+			}
+			for _, want := range tc.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateNames(t *testing.T) {
+	tests := []struct {
+		name        string
+		criterion   string
+		wantWarning string
+	}{
+		{name: "built-in criterion", criterion: "Computer Name"},
+		{name: "dynamic criterion", criterion: "Patch Reporting: Google Chrome"},
+		{name: "typo", criterion: "Computer Nmae", wantWarning: "did you mean 'Computer Name'?"},
+		{name: "case only", criterion: "computer name", wantWarning: "'computer name' is spelled 'Computer Name' in Jamf Pro"},
+		{name: "unknown", criterion: "Favourite Colour of the Secretary", wantWarning: "is not a known computer criterion or extension attribute and is not validated"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := Validate(Computers, []Criterion{criterion(tc.criterion, "is")}, nil)
+			if err != nil {
+				t.Fatalf("criterion names must not fail validation, got %v", err)
+			}
+			if tc.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Fatalf("unexpected warnings: %q", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tc.wantWarning) {
+				t.Fatalf("warnings are %q, want one containing %q", warnings, tc.wantWarning)
+			}
+		})
+	}
+}
+
+func TestValidateSearchTypes(t *testing.T) {
+	// A built-in computer criterion of each field type
+	names := map[fieldType]string{
+		fieldText:       "Computer Name",
+		fieldNumber:     "Life Expectancy",
+		fieldVersion:    "Operating System Version",
+		fieldDate:       "PO Date",
+		fieldGroup:      "Computer Group",
+		fieldCollection: "Application Title",
+		fieldChoice:     "Leased",
+	}
+	allSearchTypes := map[string]bool{}
+	for _, allowed := range searchTypes {
+		for _, searchType := range allowed {
+			allSearchTypes[searchType] = true
+		}
+	}
+
+	for ft, name := range names {
+		if got, ok := builtInFieldType(computerCriteria, name); !ok || got != ft {
+			t.Fatalf("'%s' is a %s criterion, want a %s criterion", name, got, ft)
+		}
+		for searchType := range allSearchTypes {
+			allowed := containsFold(searchTypes[ft], searchType)
+			warnings, err := Validate(Computers, []Criterion{criterion(name, searchType)}, nil)
+			if err != nil {
+				t.Fatalf("search types must not fail validation, got %v", err)
+			}
+			if allowed && len(warnings) != 0 {
+				t.Errorf("%s criterion '%s' with '%s' warns %q", ft, name, searchType, warnings)
+			}
+			if !allowed && (len(warnings) != 1 || !strings.Contains(warnings[0], "is not a known search type")) {
+				t.Errorf("%s criterion '%s' with '%s' warns %q, want a search type warning", ft, name, searchType, warnings)
+			}
+		}
+	}
+
+	// A case-only difference is checked as the criterion it names
+	warnings, _ := Validate(Computers, []Criterion{criterion("computer group", "more than")}, nil)
+	if len(warnings) != 2 || !strings.Contains(warnings[1], "the group criterion 'computer group'") {
+		t.Errorf("warnings are %q, want the search type checked against the group criterion", warnings)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"Computer Name", "Computer Name", 0},
+		{"Computer Nmae", "Computer Name", 2},
+		{"Computr Name", "Computer Name", 1},
+		{"Computer Names", "Computer Name", 1},
+		{"Kernel", "Kernal", 1},
+		{"kitten", "sitting", 3},
+		{"Größe", "Grösse", 2},
+	}
+
+	for _, tc := range tests {
+		if got := distance(tc.a, tc.b); got != tc.want {
+			t.Errorf("distance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestClosestName(t *testing.T) {
+	builtIn := map[string]fieldType{"Computer Name": fieldText, "Model": fieldText, "Serial Number": fieldText}
+	extensionAttributes := []string{"Battery Cycle Count"}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Computer Nmae", want: "Computer Name"},
+		{name: "COMPUTER NAME", want: "Computer Name"},
+		{name: "Modell", want: "Model"},
+		{name: "Battery Cycle Cuont", want: "Battery Cycle Count"},
+		// Short names only allow a single edit
+		{name: "Modle", want: ""},
+		{name: "Mdl", want: ""},
+		// Long names allow at most three edits
+		{name: "Battery Cycle Countdown", want: ""},
+		{name: "Department", want: ""},
+	}
+
+	for _, tc := range tests {
+		if got := closestName(tc.name, builtIn, extensionAttributes); got != tc.want {
+			t.Errorf("closestName(%q) = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
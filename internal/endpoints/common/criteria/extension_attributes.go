@@ -0,0 +1,140 @@
+// extension_attributes.go
+package criteria
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// extensionAttributes looks up the extension attributes of a tenant that can be used as criteria.
+type extensionAttributes struct {
+	// list returns the IDs of the extension attributes by name.
+	list func(conn *jamfpro.Client) (map[string]int, error)
+	// dataType returns the data type of an extension attribute, such as "String" or "Integer".
+	dataType func(conn *jamfpro.Client, id int) (string, error)
+}
+
+var computerExtensionAttributes = extensionAttributes{
+	list: func(conn *jamfpro.Client) (map[string]int, error) {
+		response, err := conn.GetComputerExtensionAttributes()
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]int, len(response.Results))
+		for _, item := range response.Results {
+			ids[item.Name] = item.ID
+		}
+		return ids, nil
+	},
+	dataType: func(conn *jamfpro.Client, id int) (string, error) {
+		attribute, err := conn.GetComputerExtensionAttributeByID(id)
+		if err != nil {
+			return "", err
+		}
+		return attribute.DataType, nil
+	},
+}
+
+var mobileDeviceExtensionAttributes = extensionAttributes{
+	list: func(conn *jamfpro.Client) (map[string]int, error) {
+		response, err := conn.GetMobileExtensionAttributes()
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]int, len(response.MobileDeviceExtensionAttribute))
+		for _, item := range response.MobileDeviceExtensionAttribute {
+			ids[item.Name] = item.ID
+		}
+		return ids, nil
+	},
+	dataType: func(conn *jamfpro.Client, id int) (string, error) {
+		attribute, err := conn.GetMobileExtensionAttributeByID(id)
+		if err != nil {
+			return "", err
+		}
+		return attribute.DataType, nil
+	},
+}
+
+var userExtensionAttributes = extensionAttributes{
+	list: func(conn *jamfpro.Client) (map[string]int, error) {
+		response, err := conn.GetUserExtensionAttributes()
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]int, len(response.UserExtensionAttributes))
+		for _, item := range response.UserExtensionAttributes {
+			ids[item.Name] = item.ID
+		}
+		return ids, nil
+	},
+	dataType: func(conn *jamfpro.Client, id int) (string, error) {
+		attribute, err := conn.GetUserExtensionAttributeByID(id)
+		if err != nil {
+			return "", err
+		}
+		return attribute.DataType, nil
+	},
+}
+
+// extensionAttributeCache keeps the extension attributes of each tenant for the life of the
+// provider, so that a plan with many groups and searches lists them once. Entries are refreshed
+// when a criterion names an extension attribute that is not in them, which happens when the
+// extension attribute was created after they were listed.
+type extensionAttributeCache struct {
+	mu        sync.Mutex
+	ids       map[*extensionAttributes]map[*jamfpro.Client]map[string]int
+	dataTypes map[*extensionAttributes]map[*jamfpro.Client]map[int]fieldType
+}
+
+var cache = &extensionAttributeCache{
+	ids:       map[*extensionAttributes]map[*jamfpro.Client]map[string]int{},
+	dataTypes: map[*extensionAttributes]map[*jamfpro.Client]map[int]fieldType{},
+}
+
+// names returns the IDs of the extension attributes by name, listing them again when refresh is set.
+func (c *extensionAttributeCache) names(ea *extensionAttributes, conn *jamfpro.Client, refresh bool) (map[string]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ids, ok := c.ids[ea][conn]; ok && !refresh {
+		return ids, nil
+	}
+	ids, err := ea.list(conn)
+	if err != nil {
+		return nil, err
+	}
+	if c.ids[ea] == nil {
+		c.ids[ea] = map[*jamfpro.Client]map[string]int{}
+	}
+	c.ids[ea][conn] = ids
+	return ids, nil
+}
+
+// fieldType returns the field type of an extension attribute from its data type.
+func (c *extensionAttributeCache) fieldType(ea *extensionAttributes, conn *jamfpro.Client, id int) (fieldType, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if t, ok := c.dataTypes[ea][conn][id]; ok {
+		return t, nil
+	}
+	dataType, err := ea.dataType(conn, id)
+	if err != nil {
+		return "", err
+	}
+	t, ok := extensionAttributeFieldTypes[strings.ToLower(dataType)]
+	if !ok {
+		t = fieldText
+	}
+	if c.dataTypes[ea] == nil {
+		c.dataTypes[ea] = map[*jamfpro.Client]map[int]fieldType{}
+	}
+	if c.dataTypes[ea][conn] == nil {
+		c.dataTypes[ea][conn] = map[int]fieldType{}
+	}
+	c.dataTypes[ea][conn][id] = t
+	return t, nil
+}
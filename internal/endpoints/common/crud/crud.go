@@ -0,0 +1,253 @@
+// crud.go
+// This package contains the shared create, read, update and delete implementation used by resources
+package crud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resource describes how a single Jamf Pro resource type is constructed, sent to and read back from
+// Jamf Pro. T is the SDK struct for the resource. The Create, Read, Update and Delete methods own the
+// retries, the wait for availability after creation, not found handling and logging, so a resource
+// only supplies the functions specific to its API.
+type Resource[T any] struct {
+	// Name is the resource type used in logs and diagnostics, e.g. "Jamf Pro Site".
+	Name string
+
+	// Construct builds the request body from the Terraform configuration.
+	Construct func(d *schema.ResourceData) (*T, error)
+	// State writes a resource returned by Getter into the Terraform state.
+	State func(d *schema.ResourceData, resource *T) diag.Diagnostics
+
+	// Creator creates the resource and returns its ID.
+	Creator func(conn *jamfpro.Client, resource *T) (string, error)
+	// Getter fetches the resource by ID.
+	Getter func(conn *jamfpro.Client, id string) (*T, error)
+	// Updater replaces the resource with the given ID.
+	Updater func(conn *jamfpro.Client, id string, resource *T) error
+	// Deleter removes the resource with the given ID.
+	Deleter func(conn *jamfpro.Client, id string) error
+
+	// UpdaterByName is an optional fallback used when updating by ID fails.
+	UpdaterByName func(conn *jamfpro.Client, name string, resource *T) error
+	// DeleterByName is an optional fallback used when deleting by ID fails.
+	DeleterByName func(conn *jamfpro.Client, name string) error
+
+	// StabilizationTime enables waiting for the resource to become readable after creation, followed
+	// by a stabilization period of this length. Zero reads the resource back immediately.
+	StabilizationTime time.Duration
+}
+
+// Create is responsible for creating a new resource in Jamf Pro.
+// The function:
+// 1. Constructs the resource using the provided Terraform configuration.
+// 2. Calls the API to create the resource in Jamf Pro, retrying transient failures.
+// 3. Updates the Terraform state with the ID of the newly created resource.
+// 4. Optionally waits for the resource to become available.
+// 5. Initiates a read operation to synchronize the Terraform state with the actual state in Jamf Pro.
+func (r *Resource[T]) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	resource, err := r.Construct(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct %s: %v", r.Name, err))
+	}
+
+	var id string
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var apiErr error
+		id, apiErr = r.Creator(conn, resource)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create %s%s", r.Name, describe(d)))
+	}
+
+	d.SetId(id)
+	log.Printf("[INFO] Created %s with ID '%s'", r.Name, id)
+
+	if r.StabilizationTime > 0 {
+		checkResourceExists := func(id interface{}) (interface{}, error) {
+			return r.Getter(conn, id.(string))
+		}
+
+		_, waitDiags := waitfor.ResourceIsAvailable(ctx, d, r.Name, id, checkResourceExists, r.StabilizationTime)
+		if waitDiags.HasError() {
+			return waitDiags
+		}
+	}
+
+	return r.Read(ctx, d, meta)
+}
+
+// Read is responsible for reading the current state of a resource from Jamf Pro.
+// The function:
+// 1. Fetches the resource's current state using its ID, retrying transient failures.
+// 2. Removes the resource from the Terraform state with a warning when it no longer exists.
+// 3. Otherwise updates the Terraform state with the fetched data.
+func (r *Resource[T]) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceID := d.Id()
+
+	var resource *T
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = r.Getter(conn, resourceID)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		if provider_diagnostics.IsNotFound(err) {
+			log.Printf("[WARN] %s with ID '%s' was not found, removing it from the Terraform state", r.Name, resourceID)
+			d.SetId("")
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Resource not found",
+				Detail:   fmt.Sprintf("%s with ID '%s' was not found on the server and has been removed from the Terraform state.", r.Name, resourceID),
+			}}
+		}
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read %s with ID '%s'", r.Name, resourceID))
+	}
+
+	return r.State(d, resource)
+}
+
+// Update is responsible for updating an existing resource in Jamf Pro.
+// The function:
+// 1. Constructs the resource using the provided Terraform configuration.
+// 2. Updates the resource by ID, falling back to its name when UpdaterByName is set and the
+// update failed for a reason other than the resource not being found.
+// 3. Initiates a read operation to synchronize the Terraform state with the actual state in Jamf Pro.
+func (r *Resource[T]) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceID := d.Id()
+
+	resource, err := r.Construct(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct %s for update: %v", r.Name, err))
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+		apiErr := r.Updater(conn, resourceID, resource)
+		// An object that is not found by ID was deleted outside Terraform, and one with the same
+		// name is not the object in state, so it is not updated by name
+		if apiErr != nil && r.UpdaterByName != nil && !provider_diagnostics.IsNotFound(apiErr) {
+			if name := resourceName(d); name != "" {
+				log.Printf("[WARN] Failed to update %s with ID '%s', retrying by name '%s': %v", r.Name, resourceID, name, apiErr)
+				apiErr = r.UpdaterByName(conn, name, resource)
+			}
+		}
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update %s%s (ID: %s)", r.Name, describe(d), resourceID))
+	}
+
+	log.Printf("[INFO] Updated %s with ID '%s'", r.Name, resourceID)
+
+	return r.Read(ctx, d, meta)
+}
+
+// Delete is responsible for deleting a resource from Jamf Pro. The resource is deleted by ID,
+// falling back to its name when DeleterByName is set and the delete failed for a reason other than
+// the resource not being found. A resource that no longer exists is treated as deleted.
+func (r *Resource[T]) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceID := d.Id()
+
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		apiErr := r.Deleter(conn, resourceID)
+		// An object that is not found by ID is already deleted, and one with the same name is not
+		// the object in state, so it is not deleted by name
+		if apiErr != nil && r.DeleterByName != nil && !provider_diagnostics.IsNotFound(apiErr) {
+			if name := resourceName(d); name != "" {
+				log.Printf("[WARN] Failed to delete %s with ID '%s', retrying by name '%s': %v", r.Name, resourceID, name, apiErr)
+				apiErr = r.DeleterByName(conn, name)
+			}
+		}
+		if apiErr != nil && !provider_diagnostics.IsNotFound(apiErr) {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete %s%s (ID: %s)", r.Name, describe(d), resourceID))
+	}
+
+	log.Printf("[INFO] Deleted %s with ID '%s'", r.Name, resourceID)
+	d.SetId("")
+
+	return nil
+}
+
+// IntID converts a Terraform resource ID to the integer ID used by the Classic API.
+func IntID(id string) (int, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("error converting resource ID '%s' to int: %v", id, err)
+	}
+	return intID, nil
+}
+
+// connFromMeta asserts the provider meta to the API client and returns its SDK connection.
+func connFromMeta(meta interface{}) (*jamfpro.Client, diag.Diagnostics) {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return nil, diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	return apiclient.Conn, nil
+}
+
+// resourceName returns the resource's configured name, or an empty string when it has none.
+func resourceName(d *schema.ResourceData) string {
+	name, _ := d.Get("name").(string)
+	return name
+}
+
+// describe formats the resource's name for diagnostic summaries.
+func describe(d *schema.ResourceData) string {
+	if name := resourceName(d); name != "" {
+		return fmt.Sprintf(" '%s'", name)
+	}
+	return ""
+}
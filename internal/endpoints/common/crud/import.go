@@ -0,0 +1,66 @@
+// import.go
+package crud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ImportNamePrefix marks an import ID that names the Jamf Pro object instead of giving its ID,
+// e.g. `terraform import jamfpro_site.hq "name:Headquarters"`.
+const ImportNamePrefix = "name:"
+
+// NameLookup resolves the name of a Jamf Pro object to its ID.
+type NameLookup func(conn *jamfpro.Client, name string) (string, error)
+
+// ImportByIDOrName returns an importer that accepts either the Jamf Pro ID of an object or
+// "name:<value>". Names are resolved to an ID through lookup; the resource's Read then populates
+// the rest of the state.
+func ImportByIDOrName(lookup NameLookup) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			importID := strings.TrimSpace(d.Id())
+
+			name, byName := strings.CutPrefix(importID, ImportNamePrefix)
+			if !byName {
+				if importID == "" {
+					return nil, fmt.Errorf("import ID must be a Jamf Pro ID or %s<value>", ImportNamePrefix)
+				}
+				d.SetId(importID)
+				return []*schema.ResourceData{d}, nil
+			}
+
+			if name == "" {
+				return nil, fmt.Errorf("import ID %q does not name an object", importID)
+			}
+
+			conn, diags := connFromMeta(meta)
+			if diags.HasError() {
+				return nil, fmt.Errorf("%s", diags[0].Summary)
+			}
+
+			id, err := lookup(conn, name)
+			if err != nil {
+				if provider_diagnostics.IsNotFound(err) {
+					return nil, fmt.Errorf("no object named '%s' was found in Jamf Pro", name)
+				}
+				return nil, fmt.Errorf("failed to look up object named '%s': %v", name, err)
+			}
+			if id == "" || id == "0" {
+				return nil, fmt.Errorf("no object named '%s' was found in Jamf Pro", name)
+			}
+
+			log.Printf("[INFO] Resolved import name '%s' to ID '%s'", name, id)
+			d.SetId(id)
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
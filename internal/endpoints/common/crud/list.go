@@ -0,0 +1,212 @@
+// list.go
+package crud
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ListItem is an object returned by the list endpoint of a Jamf Pro resource type.
+type ListItem struct {
+	ID   string
+	Name string
+}
+
+// List describes a data source that enumerates the objects of a Jamf Pro resource type, such as
+// jamfpro_sites. The Read method owns the retries and the filtering, so a resource type only
+// supplies the functions specific to its API.
+type List struct {
+	// Name is the resource type used in logs and diagnostics, e.g. "Jamf Pro Sites".
+	Name string
+
+	// Lister fetches the ID and name of every object.
+	Lister func(conn *jamfpro.Client) ([]ListItem, error)
+
+	// SiteGetter is an optional lookup of the ID of the site an object belongs to, with "-1" for
+	// none. When set the data source accepts a site_id filter, which fetches each object left
+	// after the name filters have been applied.
+	SiteGetter func(conn *jamfpro.Client, id string) (string, error)
+}
+
+// DataSource returns the schema of the list data source.
+func (l *List) DataSource() *schema.Resource {
+	dataSource := &schema.Resource{
+		ReadContext: l.Read,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the object with exactly this name.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Only return objects whose name matches this regular expression.",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the matching objects, in ascending order.",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching objects, in ascending order of ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the object.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the object.",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if l.SiteGetter != nil {
+		dataSource.Schema["site_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return objects that belong to the site with this ID. Use -1 for objects without a site.",
+		}
+	}
+
+	return dataSource
+}
+
+// Read is responsible for listing the objects of a resource type from Jamf Pro.
+// The function:
+// 1. Fetches the ID and name of every object, retrying transient failures.
+// 2. Applies the name, name_regex and site_id filters.
+// 3. Sets the matching objects, ordered by ID, in the Terraform state.
+func (l *List) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	var items []ListItem
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		items, apiErr = l.Lister(conn)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to list %s", l.Name))
+	}
+
+	name := d.Get("name").(string)
+	var nameRegex *regexp.Regexp
+	if pattern := d.Get("name_regex").(string); pattern != "" {
+		nameRegex = regexp.MustCompile(pattern)
+	}
+	siteID, filterBySite := "", false
+	if l.SiteGetter != nil {
+		siteID = d.Get("site_id").(string)
+		filterBySite = siteID != ""
+	}
+
+	matches := make([]ListItem, 0, len(items))
+	for _, item := range items {
+		if name != "" && item.Name != name {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(item.Name) {
+			continue
+		}
+		if filterBySite {
+			itemSiteID, err := l.SiteGetter(conn, item.ID)
+			if err != nil {
+				return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read the site of %s with ID '%s'", l.Name, item.ID))
+			}
+			if itemSiteID != siteID {
+				continue
+			}
+		}
+		matches = append(matches, item)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return lessID(matches[i].ID, matches[j].ID)
+	})
+
+	ids := make([]string, 0, len(matches))
+	flattened := make([]interface{}, 0, len(matches))
+	for _, item := range matches {
+		ids = append(ids, item.ID)
+		flattened = append(flattened, map[string]interface{}{
+			"id":   item.ID,
+			"name": item.Name,
+		})
+	}
+
+	log.Printf("[INFO] Listed %d of %d %s", len(matches), len(items), l.Name)
+
+	// The ID only has to be stable for a given set of filters
+	filters := strings.Join([]string{l.Name, name, d.Get("name_regex").(string), siteID}, "\x00")
+	d.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(filters))), 10))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("items", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// lessID orders numeric IDs by value and any others lexically after them.
+func lessID(a, b string) bool {
+	intA, errA := strconv.Atoi(a)
+	intB, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return intA < intB
+	case errA == nil:
+		return true
+	case errB == nil:
+		return false
+	default:
+		return a < b
+	}
+}
+
+// SiteID formats the ID of a Classic API site, using "-1" for objects without a site.
+func SiteID(site jamfpro.SharedResourceSite) string {
+	if site.ID == 0 {
+		return "-1"
+	}
+	return strconv.Itoa(site.ID)
+}
@@ -0,0 +1,71 @@
+// lookup.go
+package crud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceID returns the ID of the object read by a single-object data source whose schema
+// requires exactly one of id or nameKey. A configured id is returned as is. Otherwise the object
+// is looked up through lister, whose items carry the value of nameKey as their Name, and an error
+// is returned when no object or more than one object has that value. name is the object type used
+// in errors, e.g. "Jamf Pro Site".
+func DataSourceID(ctx context.Context, d *schema.ResourceData, meta interface{}, name, nameKey string, lister func(conn *jamfpro.Client) ([]ListItem, error)) (string, error) {
+	if id := d.Get("id").(string); id != "" {
+		return id, nil
+	}
+
+	objectName := d.Get(nameKey).(string)
+	if objectName == "" {
+		return "", fmt.Errorf("one of id or %s must be set to read a %s", nameKey, name)
+	}
+
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return "", fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	var items []ListItem
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		items, apiErr = lister(conn)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s with %s '%s': %v", name, nameKey, objectName, err)
+	}
+
+	var ids []string
+	for _, item := range items {
+		if item.Name == objectName {
+			ids = append(ids, item.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s with %s '%s' was found", name, nameKey, objectName)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d objects of type %s have %s '%s' (IDs: %s); set id to select one of them", len(ids), name, nameKey, objectName, strings.Join(ids, ", "))
+	}
+}
+
+// RSQLEquals returns a Jamf Pro API RSQL filter matching objects whose field equals value, quoting
+// value so that it may contain spaces, commas and quotes.
+func RSQLEquals(field, value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return fmt.Sprintf(`%s=="%s"`, field, escaped)
+}
@@ -0,0 +1,85 @@
+// remote_changes.go
+package crud
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RemotelyChangedFields compares an object fetched from Jamf Pro with the values recorded in state
+// and returns the attributes that were changed outside Terraform, such as "location_information.room".
+// state writes the fetched object onto a ResourceData with the schema of resource. Attributes named
+// in ignored, at the top level or inside single nested blocks, are skipped; use it for values that
+// change on every save, such as version locks, and for secrets Jamf Pro does not return.
+func RemotelyChangedFields(d *schema.ResourceData, resource *schema.Resource, state func(remote *schema.ResourceData) diag.Diagnostics, ignored map[string]bool) []string {
+	remote := resource.Data(nil)
+	if diags := state(remote); diags.HasError() {
+		return nil
+	}
+
+	var changed []string
+	for key := range resource.Schema {
+		if ignored[key] {
+			continue
+		}
+		prior, _ := d.GetChange(key)
+		latest := remote.Get(key)
+
+		// Compare single nested blocks attribute by attribute to name the fields that changed
+		priorBlock, priorIsBlock := singleNestedBlock(prior)
+		latestBlock, latestIsBlock := singleNestedBlock(latest)
+		if priorIsBlock && latestIsBlock {
+			for subkey, value := range priorBlock {
+				if !ignored[subkey] && !reflect.DeepEqual(value, latestBlock[subkey]) {
+					changed = append(changed, key+"."+subkey)
+				}
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(prior, latest) {
+			changed = append(changed, key)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
+// singleNestedBlock returns the attributes of a list block holding exactly one element.
+func singleNestedBlock(value interface{}) (map[string]interface{}, bool) {
+	list, ok := value.([]interface{})
+	if !ok || len(list) != 1 {
+		return nil, false
+	}
+	block, ok := list[0].(map[string]interface{})
+	return block, ok
+}
+
+// RemoteChangesDiagnostic describes the fields of an object that were changed outside Terraform
+// before an update. A warning reports changes the update overwrote; an error reports an update
+// that was still rejected for a stale version lock after being retried.
+func RemoteChangesDiagnostic(severity diag.Severity, name, displayName, id string, changed []string) diag.Diagnostic {
+	summary := fmt.Sprintf("%s '%s' (ID: %s) was changed outside Terraform", name, displayName, id)
+
+	detail := "The following fields no longer match the Terraform state: " + strings.Join(changed, ", ") + "."
+	if len(changed) == 0 {
+		detail = "Jamf Pro reported a newer version of the object than the one this update was based on."
+	}
+	if severity == diag.Error {
+		detail += " It was modified again while it was being updated. Run terraform apply again to apply the configuration on top of the latest version."
+	} else {
+		detail += " These changes have been overwritten with the values in the Terraform configuration."
+	}
+
+	return diag.Diagnostic{
+		Severity: severity,
+		Summary:  summary,
+		Detail:   detail,
+	}
+}
@@ -0,0 +1,162 @@
+// defaults.go
+// This package applies the provider's default_site and default_category to resources
+package defaults
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Kind is the kind of object a field refers to.
+type Kind int
+
+const (
+	// Site fields take the provider's default_site.
+	Site Kind = iota
+	// Category fields take the provider's default_category.
+	Category
+)
+
+// attribute returns the provider attribute holding the default for the kind.
+func (k Kind) attribute() string {
+	if k == Site {
+		return "default_site"
+	}
+	return "default_category"
+}
+
+// Format is how a field holds the object it refers to.
+type Format int
+
+const (
+	// Block is a single 'id' and 'name' block, such as 'site { id = 1, name = "London" }'.
+	Block Format = iota
+	// Name is a string holding the name of the object.
+	Name
+	// ID is a string holding the ID of the object.
+	ID
+)
+
+// Field is a site or category attribute of a resource. The attribute must be Computed so that the
+// default can be planned for it.
+type Field struct {
+	Key    string
+	Kind   Kind
+	Format Format
+
+	// ConfiguredBy is the attribute whose configuration decides whether the default applies, when
+	// Key itself is only computed, such as the ID alongside a configurable name. Defaults to Key.
+	ConfiguredBy string
+
+	// Required makes the attribute required when the provider has no default for it.
+	Required bool
+
+	// Unset is planned when the attribute is not configured and the provider has no default for it.
+	// When nil, the value in state is kept.
+	Unset interface{}
+}
+
+// CustomizeDiff returns a CustomizeDiffFunc that plans the provider's default site or category for
+// each of fields that is not configured, so that the resource is updated when the default changes.
+// Configured values always take precedence over the default.
+func CustomizeDiff(fields ...Field) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		rawConfig := diff.GetRawConfig()
+		if rawConfig.IsNull() || !rawConfig.IsKnown() {
+			return nil
+		}
+		apiclient, _ := meta.(*client.APIClient)
+
+		for _, field := range fields {
+			configuredBy := field.ConfiguredBy
+			if configuredBy == "" {
+				configuredBy = field.Key
+			}
+			if configured(rawConfig, configuredBy) {
+				continue
+			}
+
+			reference, ok, err := defaultFor(apiclient, field.Kind)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				if field.Required {
+					return fmt.Errorf("'%s' is required unless the provider sets '%s'", configuredBy, field.Kind.attribute())
+				}
+				if field.Unset != nil {
+					if err := diff.SetNew(field.Key, field.Unset); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			if err := diff.SetNew(field.Key, field.value(reference)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// value returns the reference in the format of the field.
+func (f Field) value(reference client.Reference) interface{} {
+	switch f.Format {
+	case Name:
+		return reference.Name
+	case ID:
+		return strconv.Itoa(reference.ID)
+	default:
+		return []interface{}{map[string]interface{}{
+			"id":   reference.ID,
+			"name": reference.Name,
+		}}
+	}
+}
+
+// configured reports whether key is set in the configuration. Values that are not known yet count
+// as configured, as they are set at apply.
+func configured(rawConfig cty.Value, key string) bool {
+	if !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(key) {
+		return false
+	}
+	value := rawConfig.GetAttr(key)
+	if !value.IsKnown() {
+		return true
+	}
+	if value.IsNull() {
+		return false
+	}
+	if value.Type().IsListType() || value.Type().IsSetType() {
+		return value.LengthInt() > 0
+	}
+	return true
+}
+
+// defaultFor returns the provider's default for kind, and whether there is one.
+func defaultFor(apiclient *client.APIClient, kind Kind) (client.Reference, bool, error) {
+	if apiclient == nil {
+		return client.Reference{}, false, nil
+	}
+
+	reference := apiclient.DefaultSite
+	if kind == Category {
+		reference = apiclient.DefaultCategory
+	}
+	if reference == nil {
+		return client.Reference{}, false, nil
+	}
+
+	resolved, err := reference.Resolve(apiclient.Conn)
+	if err != nil {
+		return client.Reference{}, false, err
+	}
+	return resolved, true, nil
+}
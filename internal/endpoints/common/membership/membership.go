@@ -0,0 +1,240 @@
+// membership.go
+// This package contains the shared implementation of resources that manage some of the members of a static group
+package membership
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Group is a Jamf Pro group as read to manage its members.
+type Group struct {
+	Name      string
+	IsSmart   bool
+	MemberIDs []int
+}
+
+// Resource describes a group type whose members are managed. The Create, Read, Update and Delete
+// methods add and remove only the members listed in the attribute Key, so members added by other
+// resources or in Jamf Pro are left in place. The ID of the resource is the ID of the group.
+type Resource struct {
+	// Name is the group type used in diagnostics, e.g. "Jamf Pro Computer Group".
+	Name string
+	// Members names the members in diagnostics, e.g. "computers".
+	Members string
+	// Key is the set attribute holding the IDs of the managed members, e.g. "computer_ids".
+	Key string
+
+	// Getter fetches the group with the given ID.
+	Getter func(conn *jamfpro.Client, id int) (*Group, error)
+	// Updater adds members to and removes members from the group with the given ID in a single
+	// update, leaving its other members and settings unchanged.
+	Updater func(conn *jamfpro.Client, id string, add, remove []int) error
+}
+
+// Create adds the configured members to the group, which must be a static group.
+func (r *Resource) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupID := d.Get("group_id").(string)
+
+	group, err := r.get(ctx, conn, groupID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read %s with ID '%s'", r.Name, groupID))
+	}
+	if group.IsSmart {
+		return diag.Errorf("%s '%s' (ID: %s) is a smart group; members can only be managed for static groups", r.Name, group.Name, groupID)
+	}
+
+	d.SetId(groupID)
+
+	add := sortedIDs(d.Get(r.Key).(*schema.Set))
+	if err := r.update(ctx, conn, groupID, add, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to add %s to %s '%s' (ID: %s)", r.Members, r.Name, group.Name, groupID))
+	}
+
+	return r.Read(ctx, d, meta)
+}
+
+// Read reads the members of the group. Only the members managed by the resource are recorded in
+// state, so a member removed from the group elsewhere is added back on the next apply.
+func (r *Resource) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceID := d.Id()
+
+	group, err := r.get(ctx, conn, resourceID, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		if !d.IsNewResource() && provider_diagnostics.IsNotFound(err) {
+			d.SetId("")
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "Resource not found",
+					Detail:   fmt.Sprintf("%s with ID '%s' was not found and its membership has been removed from the Terraform state.", r.Name, resourceID),
+				},
+			}
+		}
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read %s with ID '%s'", r.Name, resourceID))
+	}
+
+	managed := d.Get(r.Key).(*schema.Set)
+	var memberIDs []interface{}
+	for _, id := range group.MemberIDs {
+		if managed.Contains(id) {
+			memberIDs = append(memberIDs, id)
+		}
+	}
+
+	if err := d.Set("group_id", resourceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(r.Key, memberIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Update adds the members added to the attribute Key and removes those removed from it.
+func (r *Resource) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceID := d.Id()
+
+	previous, current := d.GetChange(r.Key)
+	add := sortedIDs(current.(*schema.Set).Difference(previous.(*schema.Set)))
+	remove := sortedIDs(previous.(*schema.Set).Difference(current.(*schema.Set)))
+
+	if err := r.update(ctx, conn, resourceID, add, remove, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update the members of %s with ID '%s'", r.Name, resourceID))
+	}
+
+	return r.Read(ctx, d, meta)
+}
+
+// Delete removes the members managed by the resource from the group.
+func (r *Resource) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, diags := connFromMeta(meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceID := d.Id()
+
+	remove := sortedIDs(d.Get(r.Key).(*schema.Set))
+	err := r.update(ctx, conn, resourceID, nil, remove, d.Timeout(schema.TimeoutDelete))
+	if err != nil && !provider_diagnostics.IsNotFound(err) {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to remove %s from %s with ID '%s'", r.Members, r.Name, resourceID))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Importer returns an importer that accepts the ID of a group or "name:<value>", resolved through
+// lookup, and records every current member of the group as managed by the resource.
+func (r *Resource) Importer(lookup crud.NameLookup) *schema.ResourceImporter {
+	importer := crud.ImportByIDOrName(lookup)
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			imported, err := importer.StateContext(ctx, d, meta)
+			if err != nil {
+				return nil, err
+			}
+
+			conn, diags := connFromMeta(meta)
+			if diags.HasError() {
+				return nil, fmt.Errorf("%s", diags[0].Summary)
+			}
+
+			for _, data := range imported {
+				group, err := r.get(ctx, conn, data.Id(), d.Timeout(schema.TimeoutRead))
+				if err != nil {
+					return nil, fmt.Errorf("failed to read %s with ID '%s': %v", r.Name, data.Id(), err)
+				}
+				if group.IsSmart {
+					return nil, fmt.Errorf("%s '%s' (ID: %s) is a smart group; members can only be managed for static groups", r.Name, group.Name, data.Id())
+				}
+
+				memberIDs := make([]interface{}, 0, len(group.MemberIDs))
+				for _, id := range group.MemberIDs {
+					memberIDs = append(memberIDs, id)
+				}
+				if err := data.Set(r.Key, memberIDs); err != nil {
+					return nil, err
+				}
+			}
+			return imported, nil
+		},
+	}
+}
+
+// get reads the group with the ID groupID, retrying until timeout.
+func (r *Resource) get(ctx context.Context, conn *jamfpro.Client, groupID string, timeout time.Duration) (*Group, error) {
+	id, err := strconv.Atoi(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("error converting %s ID '%s' to int: %v", r.Name, groupID, err)
+	}
+
+	var group *Group
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var apiErr error
+		group, apiErr = r.Getter(conn, id)
+		return provider_diagnostics.RetryError(apiErr)
+	})
+	return group, err
+}
+
+// update adds and removes members of the group with the ID groupID, retrying until timeout. Nothing
+// is sent when there are no changes.
+func (r *Resource) update(ctx context.Context, conn *jamfpro.Client, groupID string, add, remove []int, timeout time.Duration) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		return provider_diagnostics.RetryError(r.Updater(conn, groupID, add, remove))
+	})
+}
+
+// sortedIDs returns the members of a set of integers in ascending order.
+func sortedIDs(set *schema.Set) []int {
+	ids := make([]int, 0, set.Len())
+	for _, v := range set.List() {
+		ids = append(ids, v.(int))
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// connFromMeta returns the Jamf Pro client from the provider meta.
+func connFromMeta(meta interface{}) (*jamfpro.Client, diag.Diagnostics) {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return nil, diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	return apiclient.Conn, nil
+}
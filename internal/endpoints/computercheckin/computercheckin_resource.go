@@ -8,6 +8,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -125,13 +126,13 @@ func ResourceJamfProComputerCheckinCreate(ctx context.Context, d *schema.Resourc
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		apiErr := conn.UpdateComputerCheckinInformation(checkinConfig)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro Computer Check-In configuration after retries: %v", err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, "failed to apply Jamf Pro Computer Check-In configuration")
 	}
 
 	// Since this resource is a singleton, use a fixed ID to represent it in the Terraform state
@@ -166,8 +167,8 @@ func ResourceJamfProComputerCheckinRead(ctx context.Context, d *schema.ResourceD
 		var apiErr error
 		resource, apiErr = conn.GetComputerCheckinInformation()
 		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+			// Retry transient API errors, stop on permanent ones
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully read the site, exit the retry loop
 		return nil
@@ -176,7 +177,7 @@ func ResourceJamfProComputerCheckinRead(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		// Handle the final error after all retries have been exhausted
 		d.SetId("") // Remove from Terraform state if unable to read after retries
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Computer Check-In with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Computer Check-In with ID '%s'", resourceID))
 	}
 
 	// The constant ID "jamfpro_computer_checkin_singleton" is assigned to satisfy Terraform's requirement for an ID.
@@ -230,13 +231,13 @@ func ResourceJamfProComputerCheckinUpdate(ctx context.Context, d *schema.Resourc
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		apiErr := conn.UpdateComputerCheckinInformation(checkinConfig)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro Computer Check-In configuration after retries: %v", err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, "failed to apply Jamf Pro Computer Check-In configuration")
 	}
 
 	// Since this resource is a singleton, use a fixed ID to represent it in the Terraform state
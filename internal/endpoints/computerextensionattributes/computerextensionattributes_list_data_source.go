@@ -0,0 +1,34 @@
+// computerextensionattributes_list_data_source.go
+package computerextensionattributes
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProComputerExtensionAttributesList lists the Jamf Pro computer extension attributes, optionally filtered by name.
+func DataSourceJamfProComputerExtensionAttributesList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Computer Extension Attributes",
+		Lister: listComputerExtensionAttributes,
+	}
+	return list.DataSource()
+}
+
+// listComputerExtensionAttributes returns the ID and name of every Jamf Pro computer extension attribute.
+func listComputerExtensionAttributes(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetComputerExtensionAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, attribute := range response.Results {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(attribute.ID), Name: attribute.Name})
+	}
+	return items, nil
+}
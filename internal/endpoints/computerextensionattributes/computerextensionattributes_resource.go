@@ -5,11 +5,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -33,9 +34,13 @@ func ResourceJamfProComputerExtensionAttributes() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetComputerExtensionAttributeByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -142,14 +147,14 @@ func ResourceJamfProComputerExtensionAttributesCreate(ctx context.Context, d *sc
 		var apiErr error
 		creationResponse, apiErr = conn.CreateComputerExtensionAttribute(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Computer Extension Attribute '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Computer Extension Attribute '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -204,7 +209,7 @@ func ResourceJamfProComputerExtensionAttributesRead(ctx context.Context, d *sche
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -216,7 +221,7 @@ func ResourceJamfProComputerExtensionAttributesRead(ctx context.Context, d *sche
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Computer Extension Attribute with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -275,14 +280,14 @@ func ResourceJamfProComputerExtensionAttributesUpdate(ctx context.Context, d *sc
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := conn.UpdateComputerExtensionAttributeByID(resourceIDInt, resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Computer Extension Attribute '%s' (ID: %d) after retries: %v", resource.Name, resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Computer Extension Attribute '%s' (ID: %d)", resource.Name, resourceIDInt))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -322,8 +327,8 @@ func ResourceJamfProComputerExtensionAttributesDelete(ctx context.Context, d *sc
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteComputerExtensionAttributeByNameByID(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -331,7 +336,7 @@ func ResourceJamfProComputerExtensionAttributesDelete(ctx context.Context, d *sc
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Computer Extension Attribute '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Computer Extension Attribute '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -40,13 +40,13 @@ func customDiffComputeGroups(ctx context.Context, diff *schema.ResourceDiff, v i
 			}
 
 			// Validate 'name', 'and_or', and 'search_type' in each criterion.
-			if criterion["name"] == nil || criterion["name"].(string) == "" {
+			if (criterion["name"] == nil || criterion["name"].(string) == "") && diff.NewValueKnown(fmt.Sprintf("criteria.%d.name", i)) {
 				return fmt.Errorf("'name' field is required for 'criteria' at index %d when 'is_smart' is true", i)
 			}
-			if criterion["and_or"] == nil || criterion["and_or"].(string) == "" {
+			if (criterion["and_or"] == nil || criterion["and_or"].(string) == "") && diff.NewValueKnown(fmt.Sprintf("criteria.%d.and_or", i)) {
 				return fmt.Errorf("'and_or' field is required for 'criteria' at index %d when 'is_smart' is true", i)
 			}
-			if criterion["search_type"] == nil || criterion["search_type"].(string) == "" {
+			if (criterion["search_type"] == nil || criterion["search_type"].(string) == "") && diff.NewValueKnown(fmt.Sprintf("criteria.%d.search_type", i)) {
 				return fmt.Errorf("'search_type' field is required for 'criteria' at index %d when 'is_smart' is true", i)
 			}
 		}
@@ -54,3 +54,8 @@ func customDiffComputeGroups(ctx context.Context, diff *schema.ResourceDiff, v i
 
 	return nil
 }
+
+// isSmartGroup reports whether the planned group is a smart group, whose criteria are validated.
+func isSmartGroup(diff *schema.ResourceDiff) bool {
+	return diff.Get("is_smart").(bool)
+}
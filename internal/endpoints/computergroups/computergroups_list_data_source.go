@@ -0,0 +1,49 @@
+// computergroups_list_data_source.go
+package computergroups
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProComputerGroupsList lists the Jamf Pro computer groups, optionally filtered by name or site.
+func DataSourceJamfProComputerGroupsList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Computer Groups",
+		Lister:     listComputerGroups,
+		SiteGetter: getComputerGroupSiteID,
+	}
+	return list.DataSource()
+}
+
+// listComputerGroups returns the ID and name of every Jamf Pro computer group.
+func listComputerGroups(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetComputerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, group := range response.Results {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(group.ID), Name: group.Name})
+	}
+	return items, nil
+}
+
+// getComputerGroupSiteID returns the ID of the site a computer group belongs to.
+func getComputerGroupSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetComputerGroupByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.Site), nil
+}
@@ -0,0 +1,346 @@
+// computergroups_members.go
+package computergroups
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/computerinventory"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// groupComputer is a member of a static computer group as configured, identified by the first of
+// its 'id', 'serial_number' or 'name' that is set.
+type groupComputer struct {
+	key   string
+	value string
+}
+
+// String returns the member as it is reported in unresolved_computers, such as 'serial_number:C02XK1ABCDEF'.
+func (c groupComputer) String() string {
+	return c.key + ":" + c.value
+}
+
+// groupComputersFromConfig returns the configured members of a static computer group. Only the
+// attributes set in configuration identify a member, since the other attributes are filled from
+// Jamf Pro and may hold the values of a different computer that used to be at the same position.
+// known is false when the members are not yet known.
+func groupComputersFromConfig(rawConfig cty.Value) (computers []groupComputer, known bool) {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil, false
+	}
+	configured := rawConfig.GetAttr("computers")
+	if !configured.IsWhollyKnown() {
+		return nil, false
+	}
+	if configured.IsNull() {
+		return nil, true
+	}
+
+	for _, computer := range configured.AsValueSlice() {
+		member := groupComputer{}
+		if id := computer.GetAttr("id"); !id.IsNull() {
+			n, _ := id.AsBigFloat().Int64()
+			if n != 0 {
+				member = groupComputer{key: "id", value: strconv.FormatInt(n, 10)}
+			}
+		}
+		for _, key := range []string{"serial_number", "name"} {
+			if value := computer.GetAttr(key); member.key == "" && !value.IsNull() && value.AsString() != "" {
+				member = groupComputer{key: key, value: value.AsString()}
+			}
+		}
+		computers = append(computers, member)
+	}
+	return computers, true
+}
+
+// groupComputersConfigured reports whether the members of a static computer group are managed by
+// its computers blocks, as opposed to holding the members last read from Jamf Pro.
+func groupComputersConfigured(d *schema.ResourceData) bool {
+	computers, known := groupComputersFromConfig(d.GetRawConfig())
+	return !known || len(computers) > 0
+}
+
+// groupComputersFromState returns the members of a static computer group from its planned or
+// current values, for when its configuration is not available.
+func groupComputersFromState(d *schema.ResourceData) []groupComputer {
+	var computers []groupComputer
+	for _, v := range d.Get("computers").([]interface{}) {
+		computer, _ := v.(map[string]interface{})
+		switch {
+		case computer["id"].(int) != 0:
+			computers = append(computers, groupComputer{key: "id", value: strconv.Itoa(computer["id"].(int))})
+		case computer["serial_number"].(string) != "":
+			computers = append(computers, groupComputer{key: "serial_number", value: computer["serial_number"].(string)})
+		default:
+			computers = append(computers, groupComputer{key: "name", value: computer["name"].(string)})
+		}
+	}
+	return computers
+}
+
+// resolveGroupComputers looks up the computers given by serial number or name in the Jamf Pro
+// inventory. It returns the computer each member resolves to, in the configured order, with an ID
+// of 0 for the members that match no computer or more than one computer, which are also returned
+// as unresolved. Members given by ID are not looked up.
+func resolveGroupComputers(conn *jamfpro.Client, computers []groupComputer) ([]jamfpro.ComputerGroupSubsetComputer, []string, error) {
+	values := map[string][]string{}
+	for _, computer := range computers {
+		if computer.key != "id" {
+			values[computer.key] = append(values[computer.key], computer.value)
+		}
+	}
+
+	found := map[string]map[string][]computerinventory.ComputerIdentity{}
+	fields := map[string]string{"serial_number": "hardware.serialNumber", "name": "general.name"}
+	for key, field := range fields {
+		if len(values[key]) == 0 {
+			continue
+		}
+		matches, err := computerinventory.FindComputers(conn, field, values[key])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to look up computers by %s: %v", key, err)
+		}
+		found[key] = matches
+	}
+
+	resolved := make([]jamfpro.ComputerGroupSubsetComputer, len(computers))
+	var unresolved []string
+	for i, computer := range computers {
+		if computer.key == "id" {
+			resolved[i].ID, _ = strconv.Atoi(computer.value)
+			continue
+		}
+
+		matches := found[computer.key][computer.value]
+		if len(matches) != 1 {
+			unresolved = append(unresolved, computer.String())
+			continue
+		}
+
+		id, err := strconv.Atoi(matches[0].ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error converting computer ID '%s' to int: %v", matches[0].ID, err)
+		}
+		resolved[i] = jamfpro.ComputerGroupSubsetComputer{
+			ID:            id,
+			Name:          matches[0].Name,
+			SerialNumber:  matches[0].SerialNumber,
+			MacAddress:    matches[0].MacAddress,
+			AltMacAddress: matches[0].AltMacAddress,
+		}
+	}
+
+	return resolved, unresolved, nil
+}
+
+// flattenGroupComputers returns the members of a static computer group for the 'computers'
+// attribute. Members given by ID take their other attributes from known, the members last read
+// from Jamf Pro, and unresolved members only keep the identifier they were given by.
+func flattenGroupComputers(computers []groupComputer, resolved []jamfpro.ComputerGroupSubsetComputer, known []interface{}) []interface{} {
+	knownByID := make(map[int]interface{}, len(known))
+	for _, v := range known {
+		if computer, ok := v.(map[string]interface{}); ok {
+			knownByID[computer["id"].(int)] = computer
+		}
+	}
+
+	computersList := make([]interface{}, len(resolved))
+	for i, computer := range resolved {
+		if previous, ok := knownByID[computer.ID]; ok && computers[i].key == "id" {
+			computersList[i] = previous
+			continue
+		}
+
+		computerMap := map[string]interface{}{
+			"id":              computer.ID,
+			"name":            computer.Name,
+			"mac_address":     computer.MacAddress,
+			"alt_mac_address": computer.AltMacAddress,
+			"serial_number":   computer.SerialNumber,
+		}
+		if computer.ID == 0 {
+			computerMap[computers[i].key] = computers[i].value
+		}
+		computersList[i] = computerMap
+	}
+	return computersList
+}
+
+// unresolvedComputersDiagnostic warns that the members in unresolved were not added to the group.
+func unresolvedComputersDiagnostic(groupName string, unresolved []string) diag.Diagnostics {
+	if len(unresolved) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d computers could not be added to Jamf Pro Computer Group '%s'", len(unresolved), groupName),
+			Detail: fmt.Sprintf("No computer, or more than one computer, in the Jamf Pro inventory matches the following members, "+
+				"so they were not added to the group: %s. They will be added on a later apply once each resolves to a single computer.",
+				strings.Join(unresolved, ", ")),
+		},
+	}
+}
+
+// orderGroupComputers returns the members of a static computer group in the order of the computers
+// in state, followed by any other members in the order Jamf Pro returned them, so that members
+// are not reported as moved.
+func orderGroupComputers(d *schema.ResourceData, members []jamfpro.ComputerGroupSubsetComputer) []jamfpro.ComputerGroupSubsetComputer {
+	byID := make(map[int]int, len(members))
+	for i, member := range members {
+		byID[member.ID] = i
+	}
+
+	ordered := make([]jamfpro.ComputerGroupSubsetComputer, 0, len(members))
+	placed := make(map[int]bool, len(members))
+	for _, v := range d.Get("computers").([]interface{}) {
+		computer, _ := v.(map[string]interface{})
+		id, _ := computer["id"].(int)
+		if i, ok := byID[id]; ok && !placed[id] {
+			ordered = append(ordered, members[i])
+			placed[id] = true
+		}
+	}
+	for _, member := range members {
+		if !placed[member.ID] {
+			ordered = append(ordered, member)
+			placed[member.ID] = true
+		}
+	}
+	return ordered
+}
+
+// customDiffComputerGroupComputers checks that every member of a static computer group is given by
+// its id, serial_number or name and, when the members change, looks up those given by serial
+// number or name so that the members that do not resolve to a single computer are planned in
+// unresolved_computers. Diffs cannot carry warnings, so they are also logged.
+func customDiffComputerGroupComputers(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("is_smart").(bool) {
+		return nil
+	}
+
+	computers, known := groupComputersFromConfig(diff.GetRawConfig())
+	if !known {
+		return diff.SetNewComputed("unresolved_computers")
+	}
+	// Without computers blocks the members are not managed by the group, so they are left as read
+	if len(computers) == 0 {
+		return diff.SetNew("unresolved_computers", nil)
+	}
+	for i, computer := range computers {
+		if computer.key == "" {
+			return fmt.Errorf("'computers' at index %d must set 'id', 'serial_number' or 'name'", i)
+		}
+	}
+
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("computers") {
+		// Keep the members last resolved, as an empty computed list is otherwise planned as unknown
+		return diff.SetNew("unresolved_computers", diff.Get("unresolved_computers"))
+	}
+
+	resolved, unresolved, err := resolveGroupComputers(apiclient.Conn, computers)
+	if err != nil {
+		log.Printf("[WARN] Could not look up the members of Jamf Pro Computer Group '%s' at plan time: %v", diff.Get("name").(string), err)
+		return diff.SetNewComputed("unresolved_computers")
+	}
+	for _, computer := range unresolved {
+		log.Printf("[WARN] Jamf Pro Computer Group '%s' member '%s' does not resolve to a single computer and will not be added", diff.Get("name").(string), computer)
+	}
+
+	// Plan the computers the members resolve to, rather than those previously at the same positions
+	previous, _ := diff.GetChange("computers")
+	if err := diff.SetNew("computers", flattenGroupComputers(computers, resolved, previous.([]interface{}))); err != nil {
+		return err
+	}
+	return diff.SetNew("unresolved_computers", unresolved)
+}
+
+// resolveConfiguredGroupComputers resolves the configured members of a static computer group to
+// the computers to send to Jamf Pro, recording the members that do not resolve in
+// unresolved_computers and warning about them. The resolved members are set on computers so that
+// the group is read back in the configured order. No computers are returned when no computers
+// blocks are configured, so that the members of the group, which may be managed by
+// jamfpro_computer_group_membership resources, are left unchanged.
+func resolveConfiguredGroupComputers(d *schema.ResourceData, conn *jamfpro.Client) ([]jamfpro.ComputerGroupSubsetComputer, diag.Diagnostics) {
+	if d.Get("is_smart").(bool) {
+		return nil, nil
+	}
+
+	if !groupComputersConfigured(d) {
+		return nil, diag.FromErr(d.Set("unresolved_computers", nil))
+	}
+
+	computers, known := groupComputersFromConfig(d.GetRawConfig())
+	if !known {
+		computers = groupComputersFromState(d)
+	}
+
+	resolved, unresolved, err := resolveGroupComputers(conn, computers)
+	if err != nil {
+		return nil, diag.FromErr(fmt.Errorf("failed to resolve the members of Jamf Pro Computer Group '%s': %v", d.Get("name").(string), err))
+	}
+
+	if err := d.Set("unresolved_computers", unresolved); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	var members []jamfpro.ComputerGroupSubsetComputer
+	var computersList []interface{}
+	for i, computer := range flattenGroupComputers(computers, resolved, d.Get("computers").([]interface{})) {
+		if resolved[i].ID != 0 {
+			members = append(members, resolved[i])
+			computersList = append(computersList, computer)
+		}
+	}
+	if err := d.Set("computers", computersList); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return members, unresolvedComputersDiagnostic(d.Get("name").(string), unresolved)
+}
+
+// computerGroupSettings is a Classic API update of a computer group that leaves its members
+// unchanged, since an update of a jamfpro.ResourceComputerGroup always replaces them.
+type computerGroupSettings struct {
+	XMLName  xml.Name                        `xml:"computer_group"`
+	Name     string                          `xml:"name"`
+	IsSmart  bool                            `xml:"is_smart"`
+	Site     jamfpro.SharedResourceSite      `xml:"site"`
+	Criteria jamfpro.SharedContainerCriteria `xml:"criteria"`
+}
+
+// updateComputerGroupSettings updates a static computer group without changing its members, for
+// when they are not managed by its computers blocks.
+func updateComputerGroupSettings(conn *jamfpro.Client, id int, group *jamfpro.ResourceComputerGroup) error {
+	settings := computerGroupSettings{
+		Name:     group.Name,
+		IsSmart:  group.IsSmart,
+		Site:     group.Site,
+		Criteria: group.Criteria,
+	}
+	if settings.Site.ID == 0 && settings.Site.Name == "" {
+		settings.Site = jamfpro.SharedResourceSite{ID: -1, Name: "none"}
+	}
+
+	var response jamfpro.ResourceComputerGroup
+	resp, err := conn.HTTP.DoRequest("PUT", fmt.Sprintf("%s/id/%d", uriComputerGroups, id), &settings, &response)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
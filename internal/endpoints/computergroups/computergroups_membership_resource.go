@@ -0,0 +1,108 @@
+// computergroups_membership_resource.go
+package computergroups
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/membership"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriComputerGroups = "/JSSResource/computergroups"
+
+// computerGroupMembershipChange is a Classic API update of a static computer group that only adds and
+// removes the given computers, leaving its other members and settings unchanged.
+type computerGroupMembershipChange struct {
+	XMLName           xml.Name                              `xml:"computer_group"`
+	ComputerAdditions []jamfpro.ComputerGroupSubsetComputer `xml:"computer_additions>computer,omitempty"`
+	ComputerDeletions []jamfpro.ComputerGroupSubsetComputer `xml:"computer_deletions>computer,omitempty"`
+}
+
+// computerGroupMembership manages the members listed in computer_ids of a static computer group.
+var computerGroupMembership = &membership.Resource{
+	Name:    "Jamf Pro Computer Group",
+	Members: "computers",
+	Key:     "computer_ids",
+	Getter: func(conn *jamfpro.Client, id int) (*membership.Group, error) {
+		group, err := conn.GetComputerGroupByID(id)
+		if err != nil {
+			return nil, err
+		}
+		memberIDs := make([]int, 0, len(group.Computers))
+		for _, member := range group.Computers {
+			memberIDs = append(memberIDs, member.ID)
+		}
+		return &membership.Group{Name: group.Name, IsSmart: group.IsSmart, MemberIDs: memberIDs}, nil
+	},
+	Updater: updateComputerGroupMembers,
+}
+
+// ResourceJamfProComputerGroupMembership defines the schema and CRUD operations for managing some of
+// the members of a static Jamf Pro computer group in Terraform, without affecting its other members.
+func ResourceJamfProComputerGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: computerGroupMembership.Create,
+		ReadContext:   computerGroupMembership.Read,
+		UpdateContext: computerGroupMembership.Update,
+		DeleteContext: computerGroupMembership.Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
+		Importer: computerGroupMembership.Importer(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetComputerGroupByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the computer group whose members are managed.",
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be the numeric ID of a computer group"),
+				Description:  "The ID of the static computer group whose members are managed.",
+			},
+			"computer_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the computers this resource adds to the group. Only these computers are added and removed; members added by other resources or in Jamf Pro are left in place.",
+			},
+		},
+	}
+}
+
+// updateComputerGroupMembers adds computers to and removes computers from a static computer group in a
+// single update.
+func updateComputerGroupMembers(conn *jamfpro.Client, groupID string, add, remove []int) error {
+	change := computerGroupMembershipChange{}
+	for _, id := range add {
+		change.ComputerAdditions = append(change.ComputerAdditions, jamfpro.ComputerGroupSubsetComputer{ID: id})
+	}
+	for _, id := range remove {
+		change.ComputerDeletions = append(change.ComputerDeletions, jamfpro.ComputerGroupSubsetComputer{ID: id})
+	}
+
+	var response jamfpro.ResourceComputerGroup
+	resp, err := conn.HTTP.DoRequest("PUT", fmt.Sprintf("%s/id/%s", uriComputerGroups, groupID), &change, &response)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
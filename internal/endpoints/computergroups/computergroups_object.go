@@ -10,8 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// constructJamfProComputerGroup constructs a ResourceComputerGroup object from the provided schema data.
-func constructJamfProComputerGroup(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
+// constructJamfProComputerGroup constructs a ResourceComputerGroup object from the provided schema data and
+// the members of a static group, as resolved by resolveConfiguredGroupComputers.
+func constructJamfProComputerGroup(d *schema.ResourceData, computers []jamfpro.ComputerGroupSubsetComputer) (*jamfpro.ResourceComputerGroup, error) {
 	group := &jamfpro.ResourceComputerGroup{
 		Name:    d.Get("name").(string),
 		IsSmart: d.Get("is_smart").(bool),
@@ -24,6 +25,9 @@ func constructJamfProComputerGroup(d *schema.ResourceData) (*jamfpro.ResourceCom
 			ID:   siteData["id"].(int),
 			Name: siteData["name"].(string),
 		}
+	} else {
+		// Without a site block the object is taken out of any site it is in
+		group.Site = jamfpro.SharedResourceSite{ID: -1, Name: "None"}
 	}
 
 	// Handle "criteria" field
@@ -35,8 +39,8 @@ func constructJamfProComputerGroup(d *schema.ResourceData) (*jamfpro.ResourceCom
 	}
 
 	// Handle "computers" field
-	if v, ok := d.GetOk("computers"); ok && !group.IsSmart {
-		group.Computers = constructGroupComputers(v.([]interface{}))
+	if !group.IsSmart {
+		group.Computers = computers
 	}
 
 	// Serialize and pretty-print the Computer Group object as XML for logging
@@ -69,20 +73,3 @@ func constructGroupCriteria(criteriaData []interface{}) []jamfpro.SharedSubsetCr
 
 	return criteria
 }
-
-// Helper function to construct group computers from schema data
-func constructGroupComputers(computersData []interface{}) []jamfpro.ComputerGroupSubsetComputer {
-	var computers []jamfpro.ComputerGroupSubsetComputer
-	for _, comp := range computersData {
-		computerMap := comp.(map[string]interface{})
-		computers = append(computers, jamfpro.ComputerGroupSubsetComputer{
-			ID:            computerMap["id"].(int),
-			Name:          computerMap["name"].(string),
-			SerialNumber:  computerMap["serial_number"].(string),
-			MacAddress:    computerMap["mac_address"].(string),
-			AltMacAddress: computerMap["alt_mac_address"].(string),
-		})
-	}
-
-	return computers
-}
@@ -5,13 +5,17 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/criteria"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -56,10 +60,19 @@ func ResourceJamfProComputerGroups() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
-		CustomizeDiff: customDiffComputeGroups,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		CustomizeDiff: customdiff.All(
+			customDiffComputeGroups,
+			criteria.CustomizeDiff(criteria.Computers, isSmartGroup),
+			customDiffComputerGroupComputers,
+			defaults.CustomizeDiff(defaults.Field{Key: "site", Kind: defaults.Site, Format: defaults.Block, Unset: []interface{}{}}),
+		),
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetComputerGroupByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -162,6 +175,10 @@ func ResourceJamfProComputerGroups() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
+				Description: "The members of a static computer group. Each member is given by its 'id', 'serial_number' or 'name', " +
+					"used in that order of precedence, and members given by serial number or name are looked up in the Jamf Pro " +
+					"inventory when the group is applied. The other attributes are read from Jamf Pro. When no computers blocks are " +
+					"set, the members are not managed by this resource and can be managed with jamfpro_computer_group_membership.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -174,7 +191,7 @@ func ResourceJamfProComputerGroups() *schema.Resource {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Computed:    true,
-							Description: "Name of the computer used during static computer group construction.",
+							Description: "Name of the computer used during static computer group construction. Looked up in the Jamf Pro inventory when 'id' and 'serial_number' are not set.",
 						},
 						"mac_address": {
 							Type:        schema.TypeString,
@@ -192,11 +209,17 @@ func ResourceJamfProComputerGroups() *schema.Resource {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Computed:    true,
-							Description: "Serial number of the computer used during static computer group construction.",
+							Description: "Serial number of the computer used during static computer group construction. Looked up in the Jamf Pro inventory when 'id' is not set.",
 						},
 					},
 				},
 			},
+			"unresolved_computers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Members of 'computers' given by serial number or name that match no computer, or more than one computer, in the Jamf Pro inventory, such as 'serial_number:C02XK1ABCDEF'. They are not added to the group until they resolve to a single computer on a later apply.",
+			},
 		},
 	}
 }
@@ -213,8 +236,15 @@ func ResourceJamfProComputerGroupsCreate(ctx context.Context, d *schema.Resource
 	// Initialize variables
 	var diags diag.Diagnostics
 
+	// Resolve the static group members given by serial number or name
+	computers, computersDiags := resolveConfiguredGroupComputers(d, conn)
+	if computersDiags.HasError() {
+		return computersDiags
+	}
+	diags = append(diags, computersDiags...)
+
 	// Construct the resource object
-	resource, err := constructJamfProComputerGroup(d)
+	resource, err := constructJamfProComputerGroup(d, computers)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Group: %v", err))
 	}
@@ -225,14 +255,14 @@ func ResourceJamfProComputerGroupsCreate(ctx context.Context, d *schema.Resource
 		var apiErr error
 		creationResponse, apiErr = conn.CreateComputerGroup(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Computer Group '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Computer Group '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -273,19 +303,14 @@ func ResourceJamfProComputerGroupsRead(ctx context.Context, d *schema.ResourceDa
 		var apiErr error
 		resource, apiErr = conn.GetComputerGroupByID(resourceIDInt)
 		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				// Return non-retryable error with a message to avoid SDK issues
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			// Retry for other types of errors
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	// If err is not nil, check if it's due to the resource being not found
 	if err != nil {
-		if err.Error() == "resource not found, marked for deletion" {
+		if provider_diagnostics.IsNotFound(err) {
 			// Resource not found, remove from Terraform state
 			d.SetId("")
 			// Append a warning diagnostic and return
@@ -298,7 +323,7 @@ func ResourceJamfProComputerGroupsRead(ctx context.Context, d *schema.ResourceDa
 		}
 
 		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Computer Group with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Computer Group with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -310,11 +335,15 @@ func ResourceJamfProComputerGroupsRead(ctx context.Context, d *schema.ResourceDa
 			diags = append(diags, diag.FromErr(err)...)
 		}
 
-		site := map[string]interface{}{
-			"id":   resource.Site.ID,
-			"name": resource.Site.Name,
+		// Jamf Pro reports objects without a site with the ID -1
+		site := []interface{}{}
+		if resource.Site.ID > 0 {
+			site = append(site, map[string]interface{}{
+				"id":   resource.Site.ID,
+				"name": resource.Site.Name,
+			})
 		}
-		if err := d.Set("site", []interface{}{site}); err != nil {
+		if err := d.Set("site", site); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
 
@@ -338,8 +367,9 @@ func ResourceJamfProComputerGroupsRead(ctx context.Context, d *schema.ResourceDa
 
 		// Set the computers only if the group is not smart
 		if !resource.IsSmart {
-			computersList := make([]interface{}, len(resource.Computers))
-			for i, comp := range resource.Computers {
+			computers := orderGroupComputers(d, resource.Computers)
+			computersList := make([]interface{}, len(computers))
+			for i, comp := range computers {
 				computerMap := map[string]interface{}{
 					"id":              comp.ID,
 					"name":            comp.Name,
@@ -377,24 +407,37 @@ func ResourceJamfProComputerGroupsUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("error converting resource ID '%s' to int: %v", resourceID, err))
 	}
 
+	// Resolve the static group members given by serial number or name
+	computers, computersDiags := resolveConfiguredGroupComputers(d, conn)
+	if computersDiags.HasError() {
+		return computersDiags
+	}
+	diags = append(diags, computersDiags...)
+
 	// Construct the resource object
-	resource, err := constructJamfProComputerGroup(d)
+	resource, err := constructJamfProComputerGroup(d, computers)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Group for update: %v", err))
 	}
 
 	// Update operations with retries
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, apiErr := conn.UpdateComputerGroupByID(resourceIDInt, resource)
+		var apiErr error
+		if !resource.IsSmart && !groupComputersConfigured(d) {
+			// Leave the members, which may be managed by jamfpro_computer_group_membership, unchanged
+			apiErr = updateComputerGroupSettings(conn, resourceIDInt, resource)
+		} else {
+			_, apiErr = conn.UpdateComputerGroupByID(resourceIDInt, resource)
+		}
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Computer Group '%s' (ID: %d) after retries: %v", resource.Name, resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Computer Group '%s' (ID: %d)", resource.Name, resourceIDInt))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -434,8 +477,8 @@ func ResourceJamfProComputerGroupsDelete(ctx context.Context, d *schema.Resource
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteComputerGroupByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -443,7 +486,7 @@ func ResourceJamfProComputerGroupsDelete(ctx context.Context, d *schema.Resource
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Computer Group '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Computer Group '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -7,18 +7,46 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// DataSourceJamfProMacOSComputerInventory provides information about a specific computer's inventory by its ID or Name.
+// DataSourceJamfProComputerInventory provides information about a specific computer's inventory by its ID, name or serial number.
 func DataSourceJamfProComputerInventory() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceJamfProComputerInventoryRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "serial_number"},
+				Description:  "The unique identifier of the computer.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the computer. Set from the response when the 'general' section is read.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The serial number of the computer. Set from the response when the 'hardware' section is read.",
+			},
+			"sections": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The inventory sections to read, such as 'general', 'hardware' or 'applications'. Only these sections are requested from Jamf Pro and set. Every section is read when not set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(computerInventorySectionKeys, false),
+				},
 			},
 			"udid": {
 				Type:     schema.TypeString,
@@ -1328,10 +1356,11 @@ func DataSourceJamfProComputerInventory() *schema.Resource {
 	}
 }
 
-// dataSourceJamfProComputerInventoryRead fetches the details of a specific macOS Configuration Profile
-// from Jamf Pro using either its unique Name or its ID. The function prioritizes the 'name' attribute over the 'id'
-// attribute for fetching details. If neither 'name' nor 'id' is provided, it returns an error.
-// Once the details are fetched, they are set in the data source's state.
+// dataSourceJamfProComputerInventoryRead fetches the inventory of a specific computer
+// from Jamf Pro using its ID, name or serial number. Exactly one of 'id', 'name' or 'serial_number'
+// is set; a name or serial number is resolved to an ID first, and an error is returned when no
+// computer or more than one computer matches it. Only the sections listed in 'sections' are
+// requested and set in the data source's state.
 func dataSourceJamfProComputerInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Asserts 'meta' as '*client.APIClient'
 	apiclient, ok := meta.(*client.APIClient)
@@ -1340,140 +1369,60 @@ func dataSourceJamfProComputerInventoryRead(ctx context.Context, d *schema.Resou
 	}
 	conn := apiclient.Conn
 
-	var profile *jamfpro.ResourceComputerInventory
-	var err error
-
-	// Fetch profile by 'name' or 'id'
-	if v, ok := d.GetOk("name"); ok {
-		profileName, ok := v.(string)
-		if !ok {
-			return diag.Errorf("error asserting 'name' as string")
-		}
-		profile, err = conn.GetComputerInventoryByName(profileName)
-	} else if v, ok := d.GetOk("id"); ok {
-		profileID, ok := v.(string)
-		if !ok {
-			return diag.Errorf("error asserting 'id' as string")
-		}
-		profile, err = conn.GetComputerInventoryByID(profileID)
-	} else {
-		return diag.Errorf("Either 'name' or 'id' must be provided")
+	lookupKey := "name"
+	lister := computerLister("general.name", "general", d.Get("name").(string), func(computer *jamfpro.ResourceComputerInventory) string {
+		return computer.General.Name
+	})
+	if serialNumber := d.Get("serial_number").(string); serialNumber != "" {
+		lookupKey = "serial_number"
+		lister = computerLister("hardware.serialNumber", "hardware", serialNumber, func(computer *jamfpro.ResourceComputerInventory) string {
+			return computer.Hardware.SerialNumber
+		})
 	}
 
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Computer", lookupKey, lister)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to fetch macOS Configuration Profile: %v", err))
-	}
-
-	// Set top-level attributes
-	d.SetId(profile.ID)
-	d.Set("id", profile.ID)
-	d.Set("udid", profile.UDID)
-
-	// Set 'general' section
-	if err := setGeneralSection(d, profile.General); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'diskEncryption' section
-	if err := setDiskEncryptionSection(d, profile.DiskEncryption); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'purchasing' section
-	if err := setPurchasingSection(d, profile.Purchasing); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'applications' section
-	if err := setApplicationsSection(d, profile.Applications); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'storage' section
-	if err := setStorageSection(d, profile.Storage); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'userAndLocation' section
-	if err := setUserAndLocationSection(d, profile.UserAndLocation); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'hardware' section
-	if err := setHardwareSection(d, profile.Hardware); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'localUserAccounts' section
-	if err := setLocalUserAccountsSection(d, profile.LocalUserAccounts); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'certificates' section
-	if err := setCertificatesSection(d, profile.Certificates); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'attachments' section
-	if err := setAttachmentsSection(d, profile.Attachments); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'plugins' section
-	if err := setPluginsSection(d, profile.Plugins); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// Set 'packageReceipts' section
-	if err := setPackageReceiptsSection(d, profile.PackageReceipts); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'fonts' section
-	if err := setFontsSection(d, profile.Fonts); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'security' section
-	if err := setSecuritySection(d, profile.Security); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'operatingSystem' section
-	if err := setOperatingSystemSection(d, profile.OperatingSystem); err != nil {
-		return diag.FromErr(err)
-	}
-
-	// Set 'licensedSoftware' section
-	if err := setLicensedSoftwareSection(d, profile.LicensedSoftware); err != nil {
-		return diag.FromErr(err)
-	}
+	sections := configuredSections(d.Get("sections").([]interface{}), computerInventorySectionKeys)
 
-	// Set 'ibeacons' section
-	if err := setIBeaconsSection(d, profile.Ibeacons); err != nil {
-		return diag.FromErr(err)
+	var computer *jamfpro.ResourceComputerInventory
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		computer, apiErr = getComputerInventoryByID(conn, resourceID, sections)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Computer Inventory with ID '%s'", resourceID))
 	}
 
-	// Set 'softwareUpdates' section
-	if err := setSoftwareUpdatesSection(d, profile.SoftwareUpdates); err != nil {
-		return diag.FromErr(err)
+	// Set top-level attributes
+	d.SetId(computer.ID)
+	attributes := flattenComputerInventorySections(computer, sections)
+	attributes["udid"] = computer.UDID
+	if _, ok := attributes["general"]; ok {
+		attributes["name"] = computer.General.Name
 	}
-
-	// Set 'extensionAttributes' section
-	if err := setExtensionAttributesSection(d, profile.ExtensionAttributes); err != nil {
-		return diag.FromErr(err)
+	if _, ok := attributes["hardware"]; ok {
+		attributes["serial_number"] = computer.Hardware.SerialNumber
 	}
 
-	// Set 'groupMemberships' section
-	if err := setGroupMembershipsSection(d, profile.GroupMemberships); err != nil {
-		return diag.FromErr(err)
+	// Set the requested sections
+	for key, value := range attributes {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting '%s' for Jamf Pro Computer Inventory with ID '%s': %v", key, resourceID, err))
+		}
 	}
 
 	return nil
 }
 
-// setGeneralSection maps the 'general' section of the computer inventory response to the Terraform resource data and updates the state.
-func setGeneralSection(d *schema.ResourceData, general jamfpro.ComputerInventorySubsetGeneral) error {
+// flattenGeneralSection maps the 'general' section of the computer inventory response to the Terraform schema.
+func flattenGeneralSection(general jamfpro.ComputerInventorySubsetGeneral) []interface{} {
 	// Initialize a map to hold the 'general' section attributes.
 	gen := make(map[string]interface{})
 
@@ -1487,7 +1436,10 @@ func setGeneralSection(d *schema.ResourceData, general jamfpro.ComputerInventory
 	gen["barcode2"] = general.Barcode2
 	gen["asset_tag"] = general.AssetTag
 	gen["supervised"] = general.Supervised
-	gen["mdm_capable"] = general.MdmCapable.Capable
+	gen["mdm_capable"] = []interface{}{map[string]interface{}{
+		"capable":       general.MdmCapable.Capable,
+		"capable_users": general.MdmCapable.CapableUsers,
+	}}
 	gen["report_date"] = general.ReportDate
 	gen["last_contact_time"] = general.LastContactTime
 	gen["last_cloud_backup_date"] = general.LastCloudBackupDate
@@ -1524,12 +1476,11 @@ func setGeneralSection(d *schema.ResourceData, general jamfpro.ComputerInventory
 		gen["enrollment_method"] = []interface{}{enrollmentMethod}
 	}
 
-	// Set the 'general' section in the Terraform resource data.
-	return d.Set("general", []interface{}{gen})
+	return []interface{}{gen}
 }
 
-// setDiskEncryptionSection maps the 'diskEncryption' section of the computer inventory response to the Terraform resource data and updates the state.
-func setDiskEncryptionSection(d *schema.ResourceData, diskEncryption jamfpro.ComputerInventorySubsetDiskEncryption) error {
+// flattenDiskEncryptionSection maps the 'diskEncryption' section of the computer inventory response to the Terraform schema.
+func flattenDiskEncryptionSection(diskEncryption jamfpro.ComputerInventorySubsetDiskEncryption) []interface{} {
 	// Initialize a map to hold the 'diskEncryption' section attributes.
 	diskEnc := make(map[string]interface{})
 
@@ -1553,12 +1504,11 @@ func setDiskEncryptionSection(d *schema.ResourceData, diskEncryption jamfpro.Com
 	// Set 'fileVault2EnabledUserNames' in the 'diskEnc' map.
 	diskEnc["file_vault2_enabled_user_names"] = fileVaultUserNames
 
-	// Set the 'diskEncryption' section in the Terraform resource data.
-	return d.Set("disk_encryption", []interface{}{diskEnc})
+	return []interface{}{diskEnc}
 }
 
-// setPurchasingSection maps the 'purchasing' section of the computer inventory response to the Terraform resource data and updates the state.
-func setPurchasingSection(d *schema.ResourceData, purchasing jamfpro.ComputerInventorySubsetPurchasing) error {
+// flattenPurchasingSection maps the 'purchasing' section of the computer inventory response to the Terraform schema.
+func flattenPurchasingSection(purchasing jamfpro.ComputerInventorySubsetPurchasing) []interface{} {
 	// Initialize a map to hold the 'purchasing' section attributes.
 	purchasingMap := make(map[string]interface{})
 
@@ -1594,12 +1544,11 @@ func setPurchasingSection(d *schema.ResourceData, purchasing jamfpro.ComputerInv
 	}
 	purchasingMap["extension_attributes"] = extAttrs
 
-	// Set the 'purchasing' section in the Terraform resource data.
-	return d.Set("purchasing", []interface{}{purchasingMap})
+	return []interface{}{purchasingMap}
 }
 
-// setApplicationsSection maps the 'applications' section of the computer inventory response to the Terraform resource data and updates the state.
-func setApplicationsSection(d *schema.ResourceData, applications []jamfpro.ComputerInventorySubsetApplication) error {
+// flattenApplicationsSection maps the 'applications' section of the computer inventory response to the Terraform schema.
+func flattenApplicationsSection(applications []jamfpro.ComputerInventorySubsetApplication) []interface{} {
 	// Create a slice to hold the application maps.
 	apps := make([]interface{}, len(applications))
 
@@ -1621,12 +1570,11 @@ func setApplicationsSection(d *schema.ResourceData, applications []jamfpro.Compu
 		apps[i] = appMap
 	}
 
-	// Set the 'applications' section in the Terraform resource data.
-	return d.Set("applications", apps)
+	return apps
 }
 
-// setStorageSection maps the 'storage' section of the computer inventory response to the Terraform resource data and updates the state.
-func setStorageSection(d *schema.ResourceData, storage jamfpro.ComputerInventorySubsetStorage) error {
+// flattenStorageSection maps the 'storage' section of the computer inventory response to the Terraform schema.
+func flattenStorageSection(storage jamfpro.ComputerInventorySubsetStorage) []interface{} {
 	storageMap := make(map[string]interface{})
 
 	storageMap["boot_drive_available_space_megabytes"] = storage.BootDriveAvailableSpaceMegabytes
@@ -1664,12 +1612,11 @@ func setStorageSection(d *schema.ResourceData, storage jamfpro.ComputerInventory
 	}
 	storageMap["disks"] = disks
 
-	// Set the 'storage' section in the Terraform resource data.
-	return d.Set("storage", []interface{}{storageMap})
+	return []interface{}{storageMap}
 }
 
-// setUserAndLocationSection maps the 'userAndLocation' section of the computer inventory response to the Terraform resource data and updates the state.
-func setUserAndLocationSection(d *schema.ResourceData, userAndLocation jamfpro.ComputerInventorySubsetUserAndLocation) error {
+// flattenUserAndLocationSection maps the 'userAndLocation' section of the computer inventory response to the Terraform schema.
+func flattenUserAndLocationSection(userAndLocation jamfpro.ComputerInventorySubsetUserAndLocation) []interface{} {
 	userLocationMap := make(map[string]interface{})
 
 	// Map each attribute from the 'userAndLocation' object to the corresponding schema attribute
@@ -1702,12 +1649,50 @@ func setUserAndLocationSection(d *schema.ResourceData, userAndLocation jamfpro.C
 		userLocationMap["extension_attributes"] = extAttrs
 	}
 
-	// Set the 'userAndLocation' section in the Terraform resource data
-	return d.Set("user_and_location", []interface{}{userLocationMap})
+	return []interface{}{userLocationMap}
+}
+
+// flattenConfigurationProfilesSection maps the 'configurationProfiles' section of the computer inventory response to the Terraform schema.
+func flattenConfigurationProfilesSection(configurationProfiles []jamfpro.ComputerInventorySubsetConfigurationProfile) []interface{} {
+	profiles := make([]interface{}, len(configurationProfiles))
+	for i, profile := range configurationProfiles {
+		profileMap := make(map[string]interface{})
+		profileMap["id"] = profile.ID
+		profileMap["username"] = profile.Username
+		profileMap["last_installed"] = profile.LastInstalled
+		profileMap["removable"] = profile.Removable
+		profileMap["display_name"] = profile.DisplayName
+		profileMap["profile_identifier"] = profile.ProfileIdentifier
+		profiles[i] = profileMap
+	}
+	return profiles
+}
+
+// flattenPrintersSection maps the 'printers' section of the computer inventory response to the Terraform schema.
+func flattenPrintersSection(printers []jamfpro.ComputerInventorySubsetPrinter) []interface{} {
+	printerList := make([]interface{}, len(printers))
+	for i, printer := range printers {
+		printerMap := make(map[string]interface{})
+		printerMap["name"] = printer.Name
+		printerMap["type"] = printer.Type
+		printerMap["uri"] = printer.URI
+		printerMap["location"] = printer.Location
+		printerList[i] = printerMap
+	}
+	return printerList
+}
+
+// flattenServicesSection maps the 'services' section of the computer inventory response to the Terraform schema.
+func flattenServicesSection(services []jamfpro.ComputerInventorySubsetService) []interface{} {
+	serviceList := make([]interface{}, len(services))
+	for i, service := range services {
+		serviceList[i] = map[string]interface{}{"name": service.Name}
+	}
+	return serviceList
 }
 
-// setHardwareSection maps the 'hardware' section of the computer inventory response to the Terraform resource data and updates the state.
-func setHardwareSection(d *schema.ResourceData, hardware jamfpro.ComputerInventorySubsetHardware) error {
+// flattenHardwareSection maps the 'hardware' section of the computer inventory response to the Terraform schema.
+func flattenHardwareSection(hardware jamfpro.ComputerInventorySubsetHardware) []interface{} {
 	hardwareMap := make(map[string]interface{})
 
 	// Map each attribute from the 'hardware' object to the corresponding schema attribute
@@ -1757,12 +1742,11 @@ func setHardwareSection(d *schema.ResourceData, hardware jamfpro.ComputerInvento
 		hardwareMap["extension_attributes"] = extAttrs
 	}
 
-	// Set the 'hardware' section in the Terraform resource data
-	return d.Set("hardware", []interface{}{hardwareMap})
+	return []interface{}{hardwareMap}
 }
 
-// setLocalUserAccountsSection maps the 'localUserAccounts' section of the computer inventory response to the Terraform resource data and updates the state.
-func setLocalUserAccountsSection(d *schema.ResourceData, localUserAccounts []jamfpro.ComputerInventorySubsetLocalUserAccount) error {
+// flattenLocalUserAccountsSection maps the 'localUserAccounts' section of the computer inventory response to the Terraform schema.
+func flattenLocalUserAccountsSection(localUserAccounts []jamfpro.ComputerInventorySubsetLocalUserAccount) []interface{} {
 	accounts := make([]interface{}, len(localUserAccounts))
 	for i, account := range localUserAccounts {
 		acc := make(map[string]interface{})
@@ -1785,11 +1769,11 @@ func setLocalUserAccountsSection(d *schema.ResourceData, localUserAccounts []jam
 		acc["azure_active_directory_id"] = account.AzureActiveDirectoryId
 		accounts[i] = acc
 	}
-	return d.Set("localUserAccounts", accounts)
+	return accounts
 }
 
-// setCertificatesSection maps the 'certificate' section of the computer inventory response to the Terraform resource data and updates the state.
-func setCertificatesSection(d *schema.ResourceData, certificates []jamfpro.ComputerInventorySubsetCertificate) error {
+// flattenCertificatesSection maps the 'certificate' section of the computer inventory response to the Terraform schema.
+func flattenCertificatesSection(certificates []jamfpro.ComputerInventorySubsetCertificate) []interface{} {
 	certs := make([]interface{}, len(certificates))
 	for i, cert := range certificates {
 		certMap := make(map[string]interface{})
@@ -1805,11 +1789,11 @@ func setCertificatesSection(d *schema.ResourceData, certificates []jamfpro.Compu
 		certMap["issued_date"] = cert.IssuedDate
 		certs[i] = certMap
 	}
-	return d.Set("certificates", certs)
+	return certs
 }
 
-// setAttachmentsSection maps the 'attachments' section of the computer inventory response to the Terraform resource data and updates the state.
-func setAttachmentsSection(d *schema.ResourceData, attachments []jamfpro.ComputerInventorySubsetAttachment) error {
+// flattenAttachmentsSection maps the 'attachments' section of the computer inventory response to the Terraform schema.
+func flattenAttachmentsSection(attachments []jamfpro.ComputerInventorySubsetAttachment) []interface{} {
 	atts := make([]interface{}, len(attachments))
 	for i, att := range attachments {
 		attMap := make(map[string]interface{})
@@ -1819,11 +1803,11 @@ func setAttachmentsSection(d *schema.ResourceData, attachments []jamfpro.Compute
 		attMap["size_bytes"] = att.SizeBytes
 		atts[i] = attMap
 	}
-	return d.Set("attachments", atts)
+	return atts
 }
 
-// setPluginsSection maps the 'plugins' section of the computer inventory response to the Terraform resource data and updates the state.
-func setPluginsSection(d *schema.ResourceData, plugins []jamfpro.ComputerInventorySubsetPlugin) error {
+// flattenPluginsSection maps the 'plugins' section of the computer inventory response to the Terraform schema.
+func flattenPluginsSection(plugins []jamfpro.ComputerInventorySubsetPlugin) []interface{} {
 	pluginList := make([]interface{}, len(plugins))
 	for i, plugin := range plugins {
 		pluginMap := make(map[string]interface{})
@@ -1832,20 +1816,20 @@ func setPluginsSection(d *schema.ResourceData, plugins []jamfpro.ComputerInvento
 		pluginMap["path"] = plugin.Path
 		pluginList[i] = pluginMap
 	}
-	return d.Set("plugins", pluginList)
+	return pluginList
 }
 
-// setPackageReceiptsSection maps the 'package receipts' section of the computer inventory response to the Terraform resource data and updates the state.
-func setPackageReceiptsSection(d *schema.ResourceData, packageReceipts jamfpro.ComputerInventorySubsetPackageReceipts) error {
+// flattenPackageReceiptsSection maps the 'package receipts' section of the computer inventory response to the Terraform schema.
+func flattenPackageReceiptsSection(packageReceipts jamfpro.ComputerInventorySubsetPackageReceipts) []interface{} {
 	packageReceiptMap := make(map[string]interface{})
 	packageReceiptMap["installed_by_jamf_pro"] = packageReceipts.InstalledByJamfPro
 	packageReceiptMap["installed_by_installer_swu"] = packageReceipts.InstalledByInstallerSwu
 	packageReceiptMap["cached"] = packageReceipts.Cached
-	return d.Set("package_receipts", []interface{}{packageReceiptMap})
+	return []interface{}{packageReceiptMap}
 }
 
-// setFontsSection maps the 'fonts' section of the computer inventory response to the Terraform resource data and updates the state.
-func setFontsSection(d *schema.ResourceData, fonts []jamfpro.ComputerInventorySubsetFont) error {
+// flattenFontsSection maps the 'fonts' section of the computer inventory response to the Terraform schema.
+func flattenFontsSection(fonts []jamfpro.ComputerInventorySubsetFont) []interface{} {
 	fontsList := make([]interface{}, len(fonts))
 	for i, font := range fonts {
 		fontMap := make(map[string]interface{})
@@ -1854,11 +1838,11 @@ func setFontsSection(d *schema.ResourceData, fonts []jamfpro.ComputerInventorySu
 		fontMap["path"] = font.Path
 		fontsList[i] = fontMap
 	}
-	return d.Set("fonts", fontsList)
+	return fontsList
 }
 
-// setSecuritySection maps the 'security' section of the computer inventory response to the Terraform resource data and updates the state.
-func setSecuritySection(d *schema.ResourceData, security jamfpro.ComputerInventorySubsetSecurity) error {
+// flattenSecuritySection maps the 'security' section of the computer inventory response to the Terraform schema.
+func flattenSecuritySection(security jamfpro.ComputerInventorySubsetSecurity) []interface{} {
 	securityMap := make(map[string]interface{})
 	securityMap["sip_status"] = security.SipStatus
 	securityMap["gatekeeper_status"] = security.GatekeeperStatus
@@ -1871,11 +1855,11 @@ func setSecuritySection(d *schema.ResourceData, security jamfpro.ComputerInvento
 	securityMap["secure_boot_level"] = security.SecureBootLevel
 	securityMap["external_boot_level"] = security.ExternalBootLevel
 	securityMap["bootstrap_token_allowed"] = security.BootstrapTokenAllowed
-	return d.Set("security", []interface{}{securityMap})
+	return []interface{}{securityMap}
 }
 
-// setOperatingSystemSection maps the 'Operating System' section of the computer inventory response to the Terraform resource data and updates the state.
-func setOperatingSystemSection(d *schema.ResourceData, operatingSystem jamfpro.ComputerInventorySubsetOperatingSystem) error {
+// flattenOperatingSystemSection maps the 'Operating System' section of the computer inventory response to the Terraform schema.
+func flattenOperatingSystemSection(operatingSystem jamfpro.ComputerInventorySubsetOperatingSystem) []interface{} {
 	osMap := make(map[string]interface{})
 	osMap["name"] = operatingSystem.Name
 	osMap["version"] = operatingSystem.Version
@@ -1884,7 +1868,7 @@ func setOperatingSystemSection(d *schema.ResourceData, operatingSystem jamfpro.C
 	osMap["rapid_security_response"] = operatingSystem.RapidSecurityResponse
 	osMap["active_directory_status"] = operatingSystem.ActiveDirectoryStatus
 	osMap["filevault2_status"] = operatingSystem.FileVault2Status
-	osMap["softwareUpdate_device_id"] = operatingSystem.SoftwareUpdateDeviceId
+	osMap["software_update_device_id"] = operatingSystem.SoftwareUpdateDeviceId
 	// Map extension attributes if present
 	extAttrs := make([]map[string]interface{}, len(operatingSystem.ExtensionAttributes))
 	for i, attr := range operatingSystem.ExtensionAttributes {
@@ -1902,11 +1886,11 @@ func setOperatingSystemSection(d *schema.ResourceData, operatingSystem jamfpro.C
 		extAttrs[i] = attrMap
 	}
 	osMap["extension_attributes"] = extAttrs
-	return d.Set("operating_system", []interface{}{osMap})
+	return []interface{}{osMap}
 }
 
-// setLicensedSoftwareSection maps the 'Licensed Software' section of the computer inventory response to the Terraform resource data and updates the state.
-func setLicensedSoftwareSection(d *schema.ResourceData, licensedSoftware []jamfpro.ComputerInventorySubsetLicensedSoftware) error {
+// flattenLicensedSoftwareSection maps the 'Licensed Software' section of the computer inventory response to the Terraform schema.
+func flattenLicensedSoftwareSection(licensedSoftware []jamfpro.ComputerInventorySubsetLicensedSoftware) []interface{} {
 	softwareList := make([]interface{}, len(licensedSoftware))
 	for i, software := range licensedSoftware {
 		softwareMap := make(map[string]interface{})
@@ -1914,22 +1898,22 @@ func setLicensedSoftwareSection(d *schema.ResourceData, licensedSoftware []jamfp
 		softwareMap["name"] = software.Name
 		softwareList[i] = softwareMap
 	}
-	return d.Set("licensed_software", softwareList)
+	return softwareList
 }
 
-// setIBeaconsSection maps the 'IBeacons' section of the computer inventory response to the Terraform resource data and updates the state.
-func setIBeaconsSection(d *schema.ResourceData, ibeacons []jamfpro.ComputerInventorySubsetIBeacon) error {
+// flattenIBeaconsSection maps the 'IBeacons' section of the computer inventory response to the Terraform schema.
+func flattenIBeaconsSection(ibeacons []jamfpro.ComputerInventorySubsetIBeacon) []interface{} {
 	ibeaconList := make([]interface{}, len(ibeacons))
 	for i, ibeacon := range ibeacons {
 		ibeaconMap := make(map[string]interface{})
 		ibeaconMap["name"] = ibeacon.Name
 		ibeaconList[i] = ibeaconMap
 	}
-	return d.Set("ibeacons", ibeaconList)
+	return ibeaconList
 }
 
-// setSoftwareUpdatesSection maps the 'Software Updates' section of the computer inventory response to the Terraform resource data and updates the state.
-func setSoftwareUpdatesSection(d *schema.ResourceData, softwareUpdates []jamfpro.ComputerInventorySubsetSoftwareUpdate) error {
+// flattenSoftwareUpdatesSection maps the 'Software Updates' section of the computer inventory response to the Terraform schema.
+func flattenSoftwareUpdatesSection(softwareUpdates []jamfpro.ComputerInventorySubsetSoftwareUpdate) []interface{} {
 	updateList := make([]interface{}, len(softwareUpdates))
 	for i, update := range softwareUpdates {
 		updateMap := make(map[string]interface{})
@@ -1938,11 +1922,11 @@ func setSoftwareUpdatesSection(d *schema.ResourceData, softwareUpdates []jamfpro
 		updateMap["package_name"] = update.PackageName
 		updateList[i] = updateMap
 	}
-	return d.Set("software_updates", updateList)
+	return updateList
 }
 
-// setExtensionAttributesSection maps the 'Extension Attributes' section of the computer inventory response to the Terraform resource data and updates the state.
-func setExtensionAttributesSection(d *schema.ResourceData, extensionAttributes []jamfpro.ComputerInventorySubsetExtensionAttribute) error {
+// flattenExtensionAttributesSection maps the 'Extension Attributes' section of the computer inventory response to the Terraform schema.
+func flattenExtensionAttributesSection(extensionAttributes []jamfpro.ComputerInventorySubsetExtensionAttribute) []interface{} {
 	attrList := make([]interface{}, len(extensionAttributes))
 	for i, attr := range extensionAttributes {
 		attrMap := make(map[string]interface{})
@@ -1957,11 +1941,11 @@ func setExtensionAttributesSection(d *schema.ResourceData, extensionAttributes [
 		attrMap["input_type"] = attr.InputType
 		attrList[i] = attrMap
 	}
-	return d.Set("extension_attributes", attrList)
+	return attrList
 }
 
-// setGroupMembershipsSection maps the 'groupMemberships' section of the computer inventory response to the Terraform resource data and updates the state.
-func setGroupMembershipsSection(d *schema.ResourceData, groupMemberships []jamfpro.ComputerInventorySubsetGroupMembership) error {
+// flattenGroupMembershipsSection maps the 'groupMemberships' section of the computer inventory response to the Terraform schema.
+func flattenGroupMembershipsSection(groupMemberships []jamfpro.ComputerInventorySubsetGroupMembership) []interface{} {
 	memberships := make([]interface{}, len(groupMemberships))
 	for i, group := range groupMemberships {
 		groupMap := make(map[string]interface{})
@@ -1971,5 +1955,5 @@ func setGroupMembershipsSection(d *schema.ResourceData, groupMemberships []jamfp
 
 		memberships[i] = groupMap
 	}
-	return d.Set("group_memberships", memberships)
+	return memberships
 }
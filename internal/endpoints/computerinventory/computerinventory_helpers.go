@@ -0,0 +1,266 @@
+// computerinventory_helpers.go
+package computerinventory
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+)
+
+const (
+	uriComputersInventoryV1 = "/api/v1/computers-inventory"
+
+	// listPageSize is the number of computers requested per page when searching the inventory.
+	listPageSize = 100
+)
+
+// computersInventoryList is a page of the computers inventory endpoint.
+type computersInventoryList struct {
+	TotalCount int                                 `json:"totalCount"`
+	Results    []jamfpro.ResourceComputerInventory `json:"results"`
+}
+
+// computerInventorySectionKeys lists the sections of the computer inventory in the order they are
+// set. Each key is both the name of the schema attribute the section is set on and, upper cased,
+// the section requested from the Jamf Pro API.
+var computerInventorySectionKeys = []string{
+	"general",
+	"disk_encryption",
+	"purchasing",
+	"applications",
+	"storage",
+	"user_and_location",
+	"configuration_profiles",
+	"printers",
+	"services",
+	"hardware",
+	"local_user_accounts",
+	"certificates",
+	"attachments",
+	"plugins",
+	"package_receipts",
+	"fonts",
+	"security",
+	"operating_system",
+	"licensed_software",
+	"ibeacons",
+	"software_updates",
+	"extension_attributes",
+	"group_memberships",
+}
+
+// flattenComputerInventorySection maps a section of the computer inventory response to the Terraform schema.
+func flattenComputerInventorySection(computer *jamfpro.ResourceComputerInventory, key string) []interface{} {
+	switch key {
+	case "general":
+		return flattenGeneralSection(computer.General)
+	case "disk_encryption":
+		return flattenDiskEncryptionSection(computer.DiskEncryption)
+	case "purchasing":
+		return flattenPurchasingSection(computer.Purchasing)
+	case "applications":
+		return flattenApplicationsSection(computer.Applications)
+	case "storage":
+		return flattenStorageSection(computer.Storage)
+	case "user_and_location":
+		return flattenUserAndLocationSection(computer.UserAndLocation)
+	case "configuration_profiles":
+		return flattenConfigurationProfilesSection(computer.ConfigurationProfiles)
+	case "printers":
+		return flattenPrintersSection(computer.Printers)
+	case "services":
+		return flattenServicesSection(computer.Services)
+	case "hardware":
+		return flattenHardwareSection(computer.Hardware)
+	case "local_user_accounts":
+		return flattenLocalUserAccountsSection(computer.LocalUserAccounts)
+	case "certificates":
+		return flattenCertificatesSection(computer.Certificates)
+	case "attachments":
+		return flattenAttachmentsSection(computer.Attachments)
+	case "plugins":
+		return flattenPluginsSection(computer.Plugins)
+	case "package_receipts":
+		return flattenPackageReceiptsSection(computer.PackageReceipts)
+	case "fonts":
+		return flattenFontsSection(computer.Fonts)
+	case "security":
+		return flattenSecuritySection(computer.Security)
+	case "operating_system":
+		return flattenOperatingSystemSection(computer.OperatingSystem)
+	case "licensed_software":
+		return flattenLicensedSoftwareSection(computer.LicensedSoftware)
+	case "ibeacons":
+		return flattenIBeaconsSection(computer.Ibeacons)
+	case "software_updates":
+		return flattenSoftwareUpdatesSection(computer.SoftwareUpdates)
+	case "extension_attributes":
+		return flattenExtensionAttributesSection(computer.ExtensionAttributes)
+	case "group_memberships":
+		return flattenGroupMembershipsSection(computer.GroupMemberships)
+	default:
+		return nil
+	}
+}
+
+// configuredSections returns the section keys configured in the 'sections' attribute, or
+// defaults when none are configured.
+func configuredSections(configured []interface{}, defaults []string) []string {
+	if len(configured) == 0 {
+		return defaults
+	}
+	keys := make([]string, 0, len(configured))
+	for _, key := range configured {
+		keys = append(keys, key.(string))
+	}
+	return keys
+}
+
+// flattenComputerInventorySections returns the requested sections of a computer keyed by schema name.
+func flattenComputerInventorySections(computer *jamfpro.ResourceComputerInventory, sections []string) map[string]interface{} {
+	requested := make(map[string]bool, len(sections))
+	for _, key := range sections {
+		requested[key] = true
+	}
+
+	flattened := make(map[string]interface{})
+	for _, key := range computerInventorySectionKeys {
+		if requested[key] {
+			flattened[key] = flattenComputerInventorySection(computer, key)
+		}
+	}
+	return flattened
+}
+
+// sectionQuery adds a section parameter to query for each of the given section keys.
+func sectionQuery(query url.Values, sections []string) {
+	for _, key := range sections {
+		query.Add("section", strings.ToUpper(key))
+	}
+}
+
+// getComputerInventoryByID fetches the given sections of the inventory of a computer by its ID.
+func getComputerInventoryByID(conn *jamfpro.Client, id string, sections []string) (*jamfpro.ResourceComputerInventory, error) {
+	query := url.Values{}
+	sectionQuery(query, sections)
+	endpoint := fmt.Sprintf("%s/%s?%s", uriComputersInventoryV1, url.PathEscape(id), query.Encode())
+
+	var computer jamfpro.ResourceComputerInventory
+	resp, err := conn.HTTP.DoRequest("GET", endpoint, nil, &computer)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch computer inventory by ID %s: %w", id, err)
+	}
+
+	return &computer, nil
+}
+
+// getComputersInventory fetches the given sections of the inventory of every computer matching the
+// RSQL filter, in the order given by sort, requesting one page after another. An empty filter
+// matches every computer and an empty sort leaves the order to Jamf Pro.
+func getComputersInventory(conn *jamfpro.Client, filter, sort string, sections []string) ([]jamfpro.ResourceComputerInventory, error) {
+	var computers []jamfpro.ResourceComputerInventory
+	for page := 0; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("page-size", strconv.Itoa(listPageSize))
+		if filter != "" {
+			query.Set("filter", filter)
+		}
+		if sort != "" {
+			query.Set("sort", sort)
+		}
+		sectionQuery(query, sections)
+		endpoint := fmt.Sprintf("%s?%s", uriComputersInventoryV1, query.Encode())
+
+		var list computersInventoryList
+		resp, err := conn.HTTP.DoRequest("GET", endpoint, nil, &list)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to search computer inventory: %w", err)
+		}
+
+		computers = append(computers, list.Results...)
+		if len(list.Results) == 0 || len(computers) >= list.TotalCount {
+			return computers, nil
+		}
+	}
+}
+
+// computerLister returns a lister for crud.DataSourceID that searches for computers whose field
+// equals value, naming each computer by that field as read by name.
+func computerLister(field, section, value string, name func(computer *jamfpro.ResourceComputerInventory) string) func(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	return func(conn *jamfpro.Client) ([]crud.ListItem, error) {
+		computers, err := getComputersInventory(conn, crud.RSQLEquals(field, value), "", []string{section})
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]crud.ListItem, 0, len(computers))
+		for i := range computers {
+			items = append(items, crud.ListItem{ID: computers[i].ID, Name: name(&computers[i])})
+		}
+		return items, nil
+	}
+}
+
+// findComputersBatchSize is the number of values matched by each search of FindComputers, keeping
+// the filter short enough for a request URL.
+const findComputersBatchSize = 50
+
+// ComputerIdentity identifies a computer in the inventory by its ID, name, serial number and MAC addresses.
+type ComputerIdentity struct {
+	ID            string
+	Name          string
+	SerialNumber  string
+	MacAddress    string
+	AltMacAddress string
+}
+
+// FindComputers searches the inventory for computers whose field, 'general.name' or
+// 'hardware.serialNumber', equals one of values, and returns the computers found for each value.
+// Values that match no computer are absent from the result.
+func FindComputers(conn *jamfpro.Client, field string, values []string) (map[string][]ComputerIdentity, error) {
+	found := make(map[string][]ComputerIdentity)
+	for start := 0; start < len(values); start += findComputersBatchSize {
+		batch := values[start:min(start+findComputersBatchSize, len(values))]
+
+		filters := make([]string, 0, len(batch))
+		wanted := make(map[string]bool, len(batch))
+		for _, value := range batch {
+			filters = append(filters, crud.RSQLEquals(field, value))
+			wanted[value] = true
+		}
+
+		computers, err := getComputersInventory(conn, strings.Join(filters, ","), "", []string{"general", "hardware"})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range computers {
+			identity := ComputerIdentity{
+				ID:            computers[i].ID,
+				Name:          computers[i].General.Name,
+				SerialNumber:  computers[i].Hardware.SerialNumber,
+				MacAddress:    computers[i].Hardware.MacAddress,
+				AltMacAddress: computers[i].Hardware.AltMacAddress,
+			}
+			value := identity.Name
+			if field == "hardware.serialNumber" {
+				value = identity.SerialNumber
+			}
+			if wanted[value] {
+				found[value] = append(found[value], identity)
+			}
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,143 @@
+// computerinventory_search_data_source.go
+package computerinventory
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultSearchSections are the sections read by jamfpro_computers_inventory when none are configured,
+// matching the Jamf Pro API, which only returns the general section unless asked for others.
+var defaultSearchSections = []string{"general"}
+
+// DataSourceJamfProComputersInventory searches the computer inventory with an RSQL filter, returning
+// the selected sections of every matching computer.
+func DataSourceJamfProComputersInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJamfProComputersInventoryRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An RSQL query that computers must match, such as 'general.name==\"Lab*\";hardware.appleSilicon==true'. Every computer matches when not set.",
+			},
+			"sort": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The sort criteria in the format 'property:asc' or 'property:desc', separated by commas, such as 'general.name:asc'. Jamf Pro sorts by ID when not set.",
+			},
+			"sections": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The inventory sections to read for each computer, such as 'general', 'hardware' or 'applications'. Only these sections are requested from Jamf Pro and set. Only 'general' is read when not set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(computerInventorySectionKeys, false),
+				},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the matching computers, in the order returned by Jamf Pro.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"computers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching computers, in the order returned by Jamf Pro. Sections that were not requested are empty.",
+				Elem:        computerInventoryElem(),
+			},
+		},
+	}
+}
+
+// computerInventoryElem returns the schema of a computer in jamfpro_computers_inventory, which shares
+// the section schemas of jamfpro_computer_inventory.
+func computerInventoryElem() *schema.Resource {
+	single := DataSourceJamfProComputerInventory().Schema
+
+	elem := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier of the computer.",
+		},
+		"udid": single["udid"],
+	}
+	for _, key := range computerInventorySectionKeys {
+		elem[key] = single[key]
+	}
+
+	return &schema.Resource{Schema: elem}
+}
+
+// dataSourceJamfProComputersInventoryRead searches the Jamf Pro computer inventory, requesting the
+// configured sections of every computer that matches the filter one page at a time, and sets them
+// in the data source's state.
+func dataSourceJamfProComputersInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	filter := d.Get("filter").(string)
+	sort := d.Get("sort").(string)
+	sections := configuredSections(d.Get("sections").([]interface{}), defaultSearchSections)
+
+	var computers []jamfpro.ResourceComputerInventory
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		computers, apiErr = getComputersInventory(conn, filter, sort, sections)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to search Jamf Pro Computer Inventory with filter '%s'", filter))
+	}
+
+	ids := make([]string, 0, len(computers))
+	flattened := make([]interface{}, 0, len(computers))
+	for i := range computers {
+		computer := flattenComputerInventorySections(&computers[i], sections)
+		computer["id"] = computers[i].ID
+		computer["udid"] = computers[i].UDID
+
+		ids = append(ids, computers[i].ID)
+		flattened = append(flattened, computer)
+	}
+
+	log.Printf("[INFO] Found %d Jamf Pro computers matching filter '%s'", len(computers), filter)
+
+	// The ID only has to be stable for a given search
+	search := strings.Join(append([]string{filter, sort}, sections...), "\x00")
+	d.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(search))), 10))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("computers", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
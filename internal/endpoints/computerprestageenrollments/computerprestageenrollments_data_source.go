@@ -8,6 +8,8 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,20 +24,23 @@ func DataSourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the computer prestage.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "display_name"},
+				Description:  "The unique identifier of the computer prestage.",
 			},
 			"display_name": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: "The display name of the computer prestage.",
 			},
 		},
 	}
 }
 
-// DataSourceJamfProComputerPrestageEnrollmentEnrollmentRead fetches the details of a specific department from Jamf Pro using its unique ID.
+// DataSourceJamfProComputerPrestageEnrollmentEnrollmentRead fetches the details of a specific department from Jamf Pro using its unique ID or name.
 func DataSourceJamfProComputerPrestageEnrollmentEnrollmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Initialize API client
 	apiclient, ok := meta.(*client.APIClient)
@@ -46,17 +51,20 @@ func DataSourceJamfProComputerPrestageEnrollmentEnrollmentRead(ctx context.Conte
 
 	// Initialize variables
 	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Computer Prestage", "display_name", listComputerPrestageEnrollments)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	var resource *jamfpro.ResourceComputerPrestage
 
 	// Read operation with retry
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
 		resource, apiErr = conn.GetComputerPrestageByID(resourceID)
 		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+			// Retry transient API errors, stop on permanent ones
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully read the computer prestage, exit the retry loop
 		return nil
@@ -64,7 +72,7 @@ func DataSourceJamfProComputerPrestageEnrollmentEnrollmentRead(ctx context.Conte
 
 	if err != nil {
 		// Handle the final error after all retries have been exhausted
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro computer prestage enrollment with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro computer prestage enrollment with ID '%s'", resourceID))
 	}
 
 	// Check if resource data exists and set the Terraform state
@@ -0,0 +1,36 @@
+// computerprestageenrollments_helpers.go
+package computerprestageenrollments
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// unversionedPrestageFields are skipped when looking for changes made outside Terraform. Version
+// locks change on every save, and Jamf Pro does not return passwords.
+var unversionedPrestageFields = map[string]bool{
+	"id":                     true,
+	"version_lock":           true,
+	"recovery_lock_password": true,
+	"admin_password":         true,
+}
+
+// applyCurrentVersionLocks copies the version locks of the prestage currently held by Jamf Pro
+// onto resource, so that the update is not rejected for carrying the stale locks from state.
+func applyCurrentVersionLocks(resource, current *jamfpro.ResourceComputerPrestage) {
+	resource.VersionLock = current.VersionLock
+	resource.LocationInformation.VersionLock = current.LocationInformation.VersionLock
+	resource.PurchasingInformation.VersionLock = current.PurchasingInformation.VersionLock
+	resource.AccountSettings.VersionLock = current.AccountSettings.VersionLock
+}
+
+// remotelyChangedFields returns the attributes of the prestage that were changed outside Terraform.
+func remotelyChangedFields(d *schema.ResourceData, current *jamfpro.ResourceComputerPrestage) []string {
+	state := func(remote *schema.ResourceData) diag.Diagnostics {
+		return updateTerraformState(remote, current)
+	}
+	return crud.RemotelyChangedFields(d, ResourceJamfProComputerPrestageEnrollmentEnrollment(), state, unversionedPrestageFields)
+}
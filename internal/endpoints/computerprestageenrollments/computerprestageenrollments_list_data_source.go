@@ -0,0 +1,45 @@
+// computerprestageenrollments_list_data_source.go
+package computerprestageenrollments
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProComputerPrestageEnrollmentsList lists the Jamf Pro computer prestage enrollments, optionally filtered by name or site.
+func DataSourceJamfProComputerPrestageEnrollmentsList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Computer Prestage Enrollments",
+		Lister:     listComputerPrestageEnrollments,
+		SiteGetter: getComputerPrestageSiteID,
+	}
+	return list.DataSource()
+}
+
+// listComputerPrestageEnrollments returns the ID and name of every Jamf Pro computer prestage.
+func listComputerPrestageEnrollments(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetComputerPrestages("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, prestage := range response.Results {
+		items = append(items, crud.ListItem{ID: prestage.ID, Name: prestage.DisplayName})
+	}
+	return items, nil
+}
+
+// getComputerPrestageSiteID returns the ID of the site a computer prestage belongs to.
+func getComputerPrestageSiteID(conn *jamfpro.Client, id string) (string, error) {
+	resource, err := conn.GetComputerPrestageByID(id)
+	if err != nil {
+		return "", err
+	}
+	if resource.SiteId == "" {
+		return "-1", nil
+	}
+	return resource.SiteId, nil
+}
@@ -4,11 +4,12 @@ package computerprestageenrollments
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	util "github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/type_assertion"
@@ -30,6 +31,13 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetComputerPrestageByName(name)
+			if err != nil {
+				return "", err
+			}
+			return resource.ID, nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -266,8 +274,8 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 						},
 						"version_lock": {
 							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "The version lock of the location information.",
+							Computed:    true,
+							Description: "The optimistic locking version of the location information. Managed by the provider.",
 						},
 					},
 				},
@@ -345,8 +353,8 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 						},
 						"version_lock": {
 							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "The version lock.",
+							Computed:    true,
+							Description: "The optimistic locking version of the purchasing information. Managed by the provider.",
 						},
 					},
 				},
@@ -444,8 +452,8 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 			},
 			"version_lock": {
 				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "The version lock.",
+				Computed:    true,
+				Description: "The optimistic locking version of the computer prestage. Managed by the provider.",
 			},
 			"account_settings": {
 				Type:     schema.TypeList,
@@ -506,8 +514,8 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 						},
 						"version_lock": {
 							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "The version lock for account settings.",
+							Computed:    true,
+							Description: "The optimistic locking version of the account settings. Managed by the provider.",
 						},
 						"prefill_primary_account_info_feature_enabled": {
 							Type:        schema.TypeBool,
@@ -581,14 +589,14 @@ func ResourceJamfProComputerPrestageEnrollmentCreate(ctx context.Context, d *sch
 		var apiErr error
 		creationResponse, apiErr = conn.CreateComputerPrestage(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro computer prestage enrollment '%s' after retries: %v", resource.DisplayName, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro computer prestage enrollment '%s'", resource.DisplayName))
 	}
 
 	// Set the resource ID in Terraform state
@@ -635,7 +643,7 @@ func ResourceJamfProComputerPrestageEnrollmentRead(ctx context.Context, d *schem
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -647,134 +655,11 @@ func ResourceJamfProComputerPrestageEnrollmentRead(ctx context.Context, d *schem
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro computer prestage enrollment with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
-	if resource != nil {
-		// Construct a map of computer prestage attributes
-		prestageAttributes := map[string]interface{}{
-			"display_name":                          resource.DisplayName,
-			"mandatory":                             resource.Mandatory,
-			"mdm_removable":                         resource.MDMRemovable,
-			"support_phone_number":                  resource.SupportPhoneNumber,
-			"support_email_address":                 resource.SupportEmailAddress,
-			"department":                            resource.Department,
-			"default_prestage":                      resource.DefaultPrestage,
-			"enrollment_site_id":                    resource.EnrollmentSiteId,
-			"keep_existing_site_membership":         resource.KeepExistingSiteMembership,
-			"keep_existing_location_information":    resource.KeepExistingLocationInformation,
-			"authentication_prompt":                 resource.AuthenticationPrompt,
-			"prevent_activation_lock":               resource.PreventActivationLock,
-			"enable_device_based_activation_lock":   resource.EnableDeviceBasedActivationLock,
-			"device_enrollment_program_instance_id": resource.DeviceEnrollmentProgramInstanceId,
-			"skip_setup_items":                      resource.SkipSetupItems,
-			"location_information":                  resource.LocationInformation,
-			"purchasing_information":                resource.PurchasingInformation,
-			"anchor_certificates":                   resource.AnchorCertificates,
-			"enrollment_customization_id":           resource.EnrollmentCustomizationId,
-			"language":                              resource.Language,
-			"region":                                resource.Region,
-			"auto_advance_setup":                    resource.AutoAdvanceSetup,
-			"install_profiles_during_setup":         resource.InstallProfilesDuringSetup,
-			"prestage_installed_profile_ids":        resource.PrestageInstalledProfileIds,
-			"custom_package_ids":                    resource.CustomPackageIds,
-			"custom_package_distribution_point_id":  resource.CustomPackageDistributionPointId,
-			"enable_recovery_lock":                  resource.EnableRecoveryLock,
-			"recovery_lock_password_type":           resource.RecoveryLockPasswordType,
-			"recovery_lock_password":                resource.RecoveryLockPassword,
-			"rotate_recovery_lock_password":         resource.RotateRecoveryLockPassword,
-			"profile_uuid":                          resource.ProfileUuid,
-			"site_id":                               resource.SiteId,
-			"version_lock":                          resource.VersionLock,
-			"account_settings":                      resource.AccountSettings,
-		}
-
-		// Handle nested location_information
-		if locationInformation := resource.LocationInformation; locationInformation != (jamfpro.ComputerPrestageSubsetLocationInformation{}) {
-			prestageAttributes["location_information"] = []interface{}{
-				map[string]interface{}{
-					"username":      locationInformation.Username,
-					"realname":      locationInformation.Realname,
-					"phone":         locationInformation.Phone,
-					"email":         locationInformation.Email,
-					"room":          locationInformation.Room,
-					"position":      locationInformation.Position,
-					"department_id": locationInformation.DepartmentId,
-					"building_id":   locationInformation.BuildingId,
-					"id":            locationInformation.ID,
-					"version_lock":  locationInformation.VersionLock,
-				},
-			}
-		}
-		// Handle nested purchasing_information
-		if purchasingInformation := resource.PurchasingInformation; purchasingInformation != (jamfpro.ComputerPrestageSubsetPurchasingInformation{}) {
-			prestageAttributes["purchasing_information"] = []interface{}{
-				map[string]interface{}{
-					"id":                 purchasingInformation.ID,
-					"leased":             purchasingInformation.Leased,
-					"purchased":          purchasingInformation.Purchased,
-					"apple_care_id":      purchasingInformation.AppleCareId,
-					"po_number":          purchasingInformation.PONumber,
-					"vendor":             purchasingInformation.Vendor,
-					"purchase_price":     purchasingInformation.PurchasePrice,
-					"life_expectancy":    purchasingInformation.LifeExpectancy,
-					"purchasing_account": purchasingInformation.PurchasingAccount,
-					"purchasing_contact": purchasingInformation.PurchasingContact,
-					"lease_date":         purchasingInformation.LeaseDate,
-					"po_date":            purchasingInformation.PODate,
-					"warranty_date":      purchasingInformation.WarrantyDate,
-					"version_lock":       purchasingInformation.VersionLock,
-				},
-			}
-		}
-		// Add other single-level attributes
-		prestageAttributes["anchor_certificates"] = resource.AnchorCertificates
-		prestageAttributes["enrollment_customization_id"] = resource.EnrollmentCustomizationId
-		prestageAttributes["language"] = resource.Language
-		prestageAttributes["region"] = resource.Region
-		prestageAttributes["auto_advance_setup"] = resource.AutoAdvanceSetup
-		prestageAttributes["install_profiles_during_setup"] = resource.InstallProfilesDuringSetup
-		prestageAttributes["prestage_installed_profile_ids"] = resource.PrestageInstalledProfileIds
-		prestageAttributes["custom_package_ids"] = resource.CustomPackageIds
-		prestageAttributes["custom_package_distribution_point_id"] = resource.CustomPackageDistributionPointId
-		prestageAttributes["enable_recovery_lock"] = resource.EnableRecoveryLock
-		prestageAttributes["recovery_lock_password_type"] = resource.RecoveryLockPasswordType
-		prestageAttributes["recovery_lock_password"] = resource.RecoveryLockPassword
-		prestageAttributes["rotate_recovery_lock_password"] = resource.RotateRecoveryLockPassword
-		prestageAttributes["profile_uuid"] = resource.ProfileUuid
-		prestageAttributes["site_id"] = resource.SiteId
-		prestageAttributes["version_lock"] = resource.VersionLock
-		// Handle nested account_settings
-		if accountSettings := resource.AccountSettings; accountSettings != (jamfpro.ComputerPrestageSubsetAccountSettings{}) {
-			prestageAttributes["account_settings"] = []interface{}{
-				map[string]interface{}{
-					"id":                          accountSettings.ID,
-					"payload_configured":          accountSettings.PayloadConfigured,
-					"local_admin_account_enabled": accountSettings.LocalAdminAccountEnabled,
-					"admin_username":              accountSettings.AdminUsername,
-					"admin_password":              accountSettings.AdminPassword,
-					"hidden_admin_account":        accountSettings.HiddenAdminAccount,
-					"local_user_managed":          accountSettings.LocalUserManaged,
-					"user_account_type":           accountSettings.UserAccountType,
-					"version_lock":                accountSettings.VersionLock,
-					"prefill_primary_account_info_feature_enabled": accountSettings.PrefillPrimaryAccountInfoFeatureEnabled,
-					"prefill_type":                           accountSettings.PrefillType,
-					"prefill_account_full_name":              accountSettings.PrefillAccountFullName,
-					"prefill_account_user_name":              accountSettings.PrefillAccountUserName,
-					"prevent_prefill_info_from_modification": accountSettings.PreventPrefillInfoFromModification,
-				},
-			}
-		}
-		// Update the Terraform state with prestage attributes
-		for key, val := range prestageAttributes {
-			if err := d.Set(key, val); err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-
-	return nil
+	return updateTerraformState(d, resource)
 }
 
 // ResourceJamfProComputerPrestageEnrollmentUpdate is responsible for updating an existing Jamf Pro Department on the remote system.
@@ -796,19 +681,46 @@ func ResourceJamfProComputerPrestageEnrollmentUpdate(ctx context.Context, d *sch
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Disk Computer Prestage for update: %v", err))
 	}
 
+	// Jamf Pro rejects updates carrying stale version locks, so fetch the current ones first
+	current, err := conn.GetComputerPrestageByID(resourceID)
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Computer Prestage '%s' (ID: %s) before update", resource.DisplayName, resourceID))
+	}
+	changed := remotelyChangedFields(d, current)
+	applyCurrentVersionLocks(resource, current)
+
 	// Update operations with retries
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, apiErr := conn.UpdateComputerPrestageByID(resourceID, resource)
-		if apiErr != nil {
-			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+	update := func() error {
+		return retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+			_, apiErr := conn.UpdateComputerPrestageByID(resourceID, resource)
+			if apiErr != nil {
+				return provider_diagnostics.RetryError(apiErr)
+			}
+			// Successfully updated the resource, exit the retry loop
+			return nil
+		})
+	}
+	err = update()
+
+	// A lock conflict means the prestage was saved again since the locks were read, so read them once more and retry once
+	if provider_diagnostics.IsConflict(err) {
+		current, readErr := conn.GetComputerPrestageByID(resourceID)
+		if readErr == nil {
+			changed = remotelyChangedFields(d, current)
+			applyCurrentVersionLocks(resource, current)
+			err = update()
 		}
-		// Successfully updated the resource, exit the retry loop
-		return nil
-	})
+	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Computer Prestage '%s' (ID: %s) after retries: %v", resource.DisplayName, resourceID, err))
+		if provider_diagnostics.IsConflict(err) {
+			return diag.Diagnostics{crud.RemoteChangesDiagnostic(diag.Error, "Jamf Pro computer prestage enrollment", resource.DisplayName, resourceID, changed)}
+		}
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Computer Prestage '%s' (ID: %s)", resource.DisplayName, resourceID))
+	}
+
+	if len(changed) > 0 {
+		diags = append(diags, crud.RemoteChangesDiagnostic(diag.Warning, "Jamf Pro computer prestage enrollment", resource.DisplayName, resourceID, changed))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -842,8 +754,8 @@ func ResourceJamfProComputerPrestageEnrollmentDelete(ctx context.Context, d *sch
 			resourceDisplayName := d.Get("display_name").(string)
 			apiErrByDisplayName := conn.DeleteComputerPrestageByName(resourceDisplayName)
 			if apiErrByDisplayName != nil {
-				// If deletion by display name also fails, return a retryable error
-				return retry.RetryableError(apiErrByDisplayName)
+				// If deletion by display name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByDisplayName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -851,7 +763,7 @@ func ResourceJamfProComputerPrestageEnrollmentDelete(ctx context.Context, d *sch
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Computer Prestage '%s' (ID: %s) after retries: %v", d.Get("display_name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Computer Prestage '%s' (ID: %s)", d.Get("display_name").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -0,0 +1,220 @@
+// computerprestagescope_helpers.go
+package computerprestagescope
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	uriComputerPrestagesV2 = "/api/v2/computer-prestages"
+	uriDeviceEnrollments   = "/api/v1/device-enrollments"
+
+	// maxScopeBatchSize bounds the serial numbers sent in a single add-multiple or delete-multiple
+	// request, keeping large hardware refreshes within Jamf Pro's request limits.
+	maxScopeBatchSize = 1000
+
+	scopeActionAdd    = "add-multiple"
+	scopeActionRemove = "delete-multiple"
+
+	modeAuthoritative = "authoritative"
+	modeAdditive      = "additive"
+)
+
+// prestageScopeUpdate is the request body of the prestage scope add-multiple and delete-multiple
+// endpoints.
+type prestageScopeUpdate struct {
+	SerialNumbers []string `json:"serialNumbers"`
+	VersionLock   int      `json:"versionLock"`
+}
+
+// deviceEnrollmentDevices is the response of the device enrollment instance devices endpoint.
+type deviceEnrollmentDevices struct {
+	TotalCount int `json:"totalCount"`
+	Results    []struct {
+		SerialNumber string `json:"serialNumber"`
+	} `json:"results"`
+}
+
+// scopeWriter applies changes to the scope of a single prestage. Each request carries the version
+// lock returned by the previous one, so a batched change only conflicts when the scope is edited
+// elsewhere while it is being applied.
+type scopeWriter struct {
+	conn        *jamfpro.Client
+	prestageID  string
+	timeout     time.Duration
+	versionLock int
+	serials     map[string]bool
+}
+
+// newScopeWriter reads the current scope of the prestage.
+func newScopeWriter(ctx context.Context, conn *jamfpro.Client, prestageID string, timeout time.Duration) (*scopeWriter, error) {
+	w := &scopeWriter{conn: conn, prestageID: prestageID, timeout: timeout}
+	if err := w.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// refresh reads the serial numbers and version lock of the scope from Jamf Pro.
+func (w *scopeWriter) refresh(ctx context.Context) error {
+	var scope *jamfpro.ResponseDeviceScope
+	err := retry.RetryContext(ctx, w.timeout, func() *retry.RetryError {
+		var apiErr error
+		scope, apiErr = w.conn.GetDeviceScopeForComputerPrestageByID(w.prestageID)
+		return provider_diagnostics.RetryError(apiErr)
+	})
+	if err != nil {
+		return err
+	}
+
+	w.record(scope)
+	return nil
+}
+
+// record stores the serial numbers and version lock of a scope returned by Jamf Pro.
+func (w *scopeWriter) record(scope *jamfpro.ResponseDeviceScope) {
+	w.versionLock = scope.VersionLock
+	w.serials = make(map[string]bool, len(scope.Assignments))
+	for _, assignment := range scope.Assignments {
+		w.serials[assignment.SerialNumber] = true
+	}
+}
+
+// apply adds and then removes serial numbers in batches of at most maxScopeBatchSize. When a batch
+// is rejected for a stale version lock the scope is read again and the batch, less any serial
+// numbers that no longer need changing, is retried once.
+func (w *scopeWriter) apply(ctx context.Context, add, remove []string) error {
+	for _, change := range []struct {
+		action  string
+		serials []string
+	}{
+		{scopeActionAdd, add},
+		{scopeActionRemove, remove},
+	} {
+		for start := 0; start < len(change.serials); start += maxScopeBatchSize {
+			batch := change.serials[start:min(start+maxScopeBatchSize, len(change.serials))]
+
+			err := w.send(ctx, change.action, batch)
+			if provider_diagnostics.IsConflict(err) {
+				if refreshErr := w.refresh(ctx); refreshErr != nil {
+					return refreshErr
+				}
+				err = w.send(ctx, change.action, w.pending(change.action, batch))
+			}
+			if err != nil {
+				return fmt.Errorf("failed to %s %d serial numbers of computer prestage %s: %w", scopeActionVerb(change.action), len(batch), w.prestageID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// pending returns the serial numbers of batch that still need action applied to them.
+func (w *scopeWriter) pending(action string, batch []string) []string {
+	var serials []string
+	for _, serial := range batch {
+		if w.serials[serial] == (action == scopeActionRemove) {
+			serials = append(serials, serial)
+		}
+	}
+	return serials
+}
+
+// send posts a single batch to the add-multiple or delete-multiple endpoint.
+func (w *scopeWriter) send(ctx context.Context, action string, serials []string) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	endpoint := fmt.Sprintf("%s/%s/scope/%s", uriComputerPrestagesV2, w.prestageID, action)
+	request := &prestageScopeUpdate{SerialNumbers: serials, VersionLock: w.versionLock}
+
+	return retry.RetryContext(ctx, w.timeout, func() *retry.RetryError {
+		var scope jamfpro.ResponseDeviceScope
+		resp, apiErr := w.conn.HTTP.DoRequest("POST", endpoint, request, &scope)
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+
+		w.record(&scope)
+		return nil
+	})
+}
+
+// scopeActionVerb describes a scope action in error messages.
+func scopeActionVerb(action string) string {
+	if action == scopeActionRemove {
+		return "remove"
+	}
+	return "add"
+}
+
+// getDeviceEnrollmentSerialNumbers returns the serial numbers Apple Business Manager has assigned
+// to the device enrollment instance.
+func getDeviceEnrollmentSerialNumbers(conn *jamfpro.Client, instanceID string) (map[string]bool, error) {
+	endpoint := fmt.Sprintf("%s/%s/devices", uriDeviceEnrollments, instanceID)
+
+	var devices deviceEnrollmentDevices
+	resp, err := conn.HTTP.DoRequest("GET", endpoint, nil, &devices)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the devices of device enrollment instance %s: %w", instanceID, err)
+	}
+
+	serials := make(map[string]bool, len(devices.Results))
+	for _, device := range devices.Results {
+		serials[device.SerialNumber] = true
+	}
+	return serials, nil
+}
+
+// difference returns the sorted serial numbers of a that are not in b.
+func difference(a, b map[string]bool) []string {
+	var serials []string
+	for serial := range a {
+		if !b[serial] {
+			serials = append(serials, serial)
+		}
+	}
+	sort.Strings(serials)
+	return serials
+}
+
+// intersection returns the serial numbers present in both a and b.
+func intersection(a, b map[string]bool) map[string]bool {
+	serials := make(map[string]bool)
+	for serial := range a {
+		if b[serial] {
+			serials[serial] = true
+		}
+	}
+	return serials
+}
+
+// sortedKeys returns the serial numbers of set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	return difference(set, nil)
+}
+
+// stringSet converts a Terraform set of strings into a lookup map.
+func stringSet(set *schema.Set) map[string]bool {
+	serials := make(map[string]bool, set.Len())
+	for _, v := range set.List() {
+		serials[v.(string)] = true
+	}
+	return serials
+}
@@ -0,0 +1,277 @@
+// computerprestagescope_resource.go
+package computerprestagescope
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProComputerPrestageScope defines the schema and CRUD operations for managing the serial
+// numbers assigned to a Jamf Pro computer prestage in Terraform.
+func ResourceJamfProComputerPrestageScope() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceJamfProComputerPrestageScopeCreate,
+		ReadContext:   ResourceJamfProComputerPrestageScopeRead,
+		UpdateContext: ResourceJamfProComputerPrestageScopeUpdate,
+		DeleteContext: ResourceJamfProComputerPrestageScopeDelete,
+		CustomizeDiff: customDiffUnassignedSerialNumbers,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Second),
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(120 * time.Second),
+			Delete: schema.DefaultTimeout(120 * time.Second),
+		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetComputerPrestageByName(name)
+			if err != nil {
+				return "", err
+			}
+			return resource.ID, nil
+		}),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the computer prestage whose scope is managed.",
+			},
+			"prestage_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the computer prestage whose scope is managed.",
+			},
+			"serial_numbers": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The serial numbers of the computers assigned to the prestage.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Z0-9]+$`), "serial numbers must contain only upper case letters and digits"),
+				},
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      modeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{modeAuthoritative, modeAdditive}, false),
+				Description: "How the scope is managed. 'authoritative' makes serial_numbers the complete scope of the prestage, " +
+					"removing any other serial numbers. 'additive' only adds and removes the serial numbers listed here, leaving " +
+					"assignments made elsewhere in place.",
+			},
+			"unassigned_serial_numbers": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Serial numbers from serial_numbers that Apple Business Manager has not assigned to the prestage's device enrollment instance. They are added on a later apply once they have been assigned.",
+			},
+		},
+	}
+}
+
+// ResourceJamfProComputerPrestageScopeCreate assigns the configured serial numbers to the prestage.
+func ResourceJamfProComputerPrestageScopeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("prestage_id").(string))
+
+	diags := syncPrestageScope(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return append(diags, ResourceJamfProComputerPrestageScopeRead(ctx, d, meta)...)
+}
+
+// ResourceJamfProComputerPrestageScopeRead reads the serial numbers assigned to the prestage. In
+// additive mode only the serial numbers managed by this resource are recorded in state.
+func ResourceJamfProComputerPrestageScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	resourceID := d.Id()
+
+	var scope *jamfpro.ResponseDeviceScope
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		scope, apiErr = conn.GetDeviceScopeForComputerPrestageByID(resourceID)
+		return provider_diagnostics.RetryError(apiErr)
+	})
+
+	if err != nil {
+		if !d.IsNewResource() && provider_diagnostics.IsNotFound(err) {
+			d.SetId("")
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "Resource not found",
+					Detail:   fmt.Sprintf("Jamf Pro computer prestage with ID '%s' was not found and its scope has been removed from the Terraform state.", resourceID),
+				},
+			}
+		}
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read the scope of Jamf Pro computer prestage '%s'", resourceID))
+	}
+
+	managed := stringSet(d.Get("serial_numbers").(*schema.Set))
+	var serialNumbers []interface{}
+	for _, assignment := range scope.Assignments {
+		if d.Get("mode").(string) != modeAdditive || managed[assignment.SerialNumber] {
+			serialNumbers = append(serialNumbers, assignment.SerialNumber)
+		}
+	}
+
+	if err := d.Set("prestage_id", resourceID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("serial_numbers", serialNumbers); err != nil {
+		return diag.FromErr(err)
+	}
+	if _, ok := d.GetOk("mode"); !ok {
+		if err := d.Set("mode", modeAuthoritative); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// ResourceJamfProComputerPrestageScopeUpdate brings the prestage scope in line with the configured
+// serial numbers.
+func ResourceJamfProComputerPrestageScopeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := syncPrestageScope(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, ResourceJamfProComputerPrestageScopeRead(ctx, d, meta)...)
+}
+
+// ResourceJamfProComputerPrestageScopeDelete removes serial numbers from the prestage: every
+// serial number in authoritative mode, and only those managed by this resource in additive mode.
+func ResourceJamfProComputerPrestageScopeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+
+	resourceID := d.Id()
+
+	writer, err := newScopeWriter(ctx, apiclient.Conn, resourceID, d.Timeout(schema.TimeoutDelete))
+	if provider_diagnostics.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read the scope of Jamf Pro computer prestage '%s'", resourceID))
+	}
+
+	remove := writer.serials
+	if d.Get("mode").(string) == modeAdditive {
+		remove = intersection(stringSet(d.Get("serial_numbers").(*schema.Set)), writer.serials)
+	}
+
+	if err := writer.apply(ctx, nil, sortedKeys(remove)); err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to clear the scope of Jamf Pro computer prestage '%s'", resourceID))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// syncPrestageScope adds the configured serial numbers missing from the prestage scope and removes
+// those that should no longer be assigned. Serial numbers that Apple Business Manager has not
+// assigned to the prestage's device enrollment instance are not sent, since Jamf Pro rejects them,
+// and are reported instead.
+func syncPrestageScope(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	var diags diag.Diagnostics
+	resourceID := d.Id()
+
+	writer, err := newScopeWriter(ctx, conn, resourceID, timeout)
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read the scope of Jamf Pro computer prestage '%s'", resourceID))
+	}
+
+	desired := stringSet(d.Get("serial_numbers").(*schema.Set))
+	add := difference(desired, writer.serials)
+
+	var remove []string
+	if d.Get("mode").(string) == modeAdditive {
+		previous, _ := d.GetChange("serial_numbers")
+		remove = difference(intersection(stringSet(previous.(*schema.Set)), writer.serials), desired)
+	} else {
+		remove = difference(writer.serials, desired)
+	}
+
+	var unassigned []string
+	if len(add) > 0 {
+		prestage, err := conn.GetComputerPrestageByID(resourceID)
+		if err != nil {
+			return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro computer prestage '%s'", resourceID))
+		}
+
+		instanceID := prestage.DeviceEnrollmentProgramInstanceId
+		enrolled, err := getDeviceEnrollmentSerialNumbers(conn, instanceID)
+		if err != nil {
+			return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to check the serial numbers for Jamf Pro computer prestage '%s'", resourceID))
+		}
+
+		var assignable []string
+		for _, serial := range add {
+			if enrolled[serial] {
+				assignable = append(assignable, serial)
+			} else {
+				unassigned = append(unassigned, serial)
+			}
+		}
+		add = assignable
+
+		if len(unassigned) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%d serial numbers are not assigned to device enrollment instance %s", len(unassigned), instanceID),
+				Detail: fmt.Sprintf("Apple Business Manager has not assigned the following serial numbers to the device enrollment instance "+
+					"of Jamf Pro computer prestage '%s', so they were not added to its scope: %s. They will be added on a later apply "+
+					"once they have been assigned.", resourceID, strings.Join(unassigned, ", ")),
+			})
+		}
+	}
+
+	if err := d.Set("unassigned_serial_numbers", unassigned); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := writer.apply(ctx, add, remove); err != nil {
+		return append(diags, provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update the scope of Jamf Pro computer prestage '%s'", resourceID))...)
+	}
+
+	return diags
+}
+
+// customDiffUnassignedSerialNumbers marks unassigned_serial_numbers as unknown whenever the
+// configured serial numbers change, since it is only known once Apple Business Manager is checked.
+func customDiffUnassignedSerialNumbers(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("serial_numbers") {
+		return d.SetNewComputed("unassigned_serial_numbers")
+	}
+	return nil
+}
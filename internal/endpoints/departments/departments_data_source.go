@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,12 +19,15 @@ func DataSourceJamfProDepartments() *schema.Resource {
 		ReadContext: DataSourceJamfProDepartmentsRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the department.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the department.",
 			},
 			"name": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The unique name of the jamf pro department.",
 			},
@@ -30,7 +35,7 @@ func DataSourceJamfProDepartments() *schema.Resource {
 	}
 }
 
-// DataSourceJamfProDepartmentsRead fetches the details of a specific department from Jamf Pro using its unique ID.
+// DataSourceJamfProDepartmentsRead fetches the details of a specific department from Jamf Pro using its unique ID or name.
 func DataSourceJamfProDepartmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Initialize API client
 	apiclient, ok := meta.(*client.APIClient)
@@ -43,12 +48,15 @@ func DataSourceJamfProDepartmentsRead(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 
 	// Get the department ID from the data source's arguments
-	resourceID := d.Get("id").(string)
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Department", "name", listDepartments)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Attempt to fetch the department's details using its ID
 	department, err := conn.GetDepartmentByID(resourceID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Department with ID '%s': %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Department with ID '%s'", resourceID))
 	}
 
 	// Check if resource data exists and set the Terraform state
@@ -0,0 +1,32 @@
+// departments_list_data_source.go
+package departments
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProDepartmentsList lists the Jamf Pro departments, optionally filtered by name.
+func DataSourceJamfProDepartmentsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Departments",
+		Lister: listDepartments,
+	}
+	return list.DataSource()
+}
+
+// listDepartments returns the ID and name of every Jamf Pro department.
+func listDepartments(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetDepartments("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, department := range response.Results {
+		items = append(items, crud.ListItem{ID: department.ID, Name: department.Name})
+	}
+	return items, nil
+}
@@ -4,11 +4,12 @@ package departments
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,9 +30,13 @@ func ResourceJamfProDepartments() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetDepartmentByName(name)
+			if err != nil {
+				return "", err
+			}
+			return resource.ID, nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -75,7 +80,7 @@ func ResourceJamfProDepartmentsCreate(ctx context.Context, d *schema.ResourceDat
 	// Attempt to create the department in Jamf Pro
 	creationResponse, err := conn.CreateDepartment(department)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Department '%s': %v", resourceName, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Department '%s'", resourceName))
 	}
 
 	// Set the resource ID in the Terraform state
@@ -114,19 +119,14 @@ func ResourceJamfProDepartmentsRead(ctx context.Context, d *schema.ResourceData,
 		var apiErr error
 		resource, apiErr = conn.GetDepartmentByID(resourceID)
 		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				// Return non-retryable error with a message to avoid SDK issues
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			// Retry for other types of errors
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	// If err is not nil, check if it's due to the resource being not found
 	if err != nil {
-		if err.Error() == "resource not found, marked for deletion" {
+		if provider_diagnostics.IsNotFound(err) {
 			// Resource not found, remove from Terraform state
 			d.SetId("")
 			// Append a warning diagnostic and return
@@ -139,7 +139,7 @@ func ResourceJamfProDepartmentsRead(ctx context.Context, d *schema.ResourceData,
 		}
 
 		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Distribution Point with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Distribution Point with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -187,8 +187,8 @@ func ResourceJamfProDepartmentsUpdate(ctx context.Context, d *schema.ResourceDat
 		// If update by ID fails, attempt to update by Name
 		_, apiErrByName := conn.UpdateDepartmentByName(resourceName, department)
 		if apiErrByName != nil {
-			// Log the error and return a retryable error
-			return retry.RetryableError(fmt.Errorf("failed to update department '%s' by ID '%s' and by name due to errors: %v, %v", resourceName, resourceID, apiErr, apiErrByName))
+			// Log the error and retry unless the failure is permanent
+			return provider_diagnostics.RetryError(fmt.Errorf("failed to update department '%s' by ID '%s' and by name due to errors: %v, %v", resourceName, resourceID, apiErr, apiErrByName))
 		}
 
 		// Successfully updated the department by name, exit the retry loop
@@ -235,8 +235,8 @@ func ResourceJamfProDepartmentsDelete(ctx context.Context, d *schema.ResourceDat
 			// If deletion by ID fails, attempt to delete by Name
 			apiErrByName := conn.DeleteDepartmentByName(resourceName)
 			if apiErrByName != nil {
-				// Log the error and return a retryable error
-				return retry.RetryableError(fmt.Errorf("failed to delete department '%s' by ID '%s' and by name due to errors: %v, %v", resourceName, resourceID, apiErr, apiErrByName))
+				// Log the error and retry unless the failure is permanent
+				return provider_diagnostics.RetryError(fmt.Errorf("failed to delete department '%s' by ID '%s' and by name due to errors: %v, %v", resourceName, resourceID, apiErr, apiErrByName))
 			}
 		}
 		// Successfully deleted the department, exit the retry loop
@@ -0,0 +1,34 @@
+// diskencryptionconfigurations_list_data_source.go
+package diskencryptionconfigurations
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProDiskEncryptionConfigurationsList lists the Jamf Pro disk encryption configurations, optionally filtered by name.
+func DataSourceJamfProDiskEncryptionConfigurationsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Disk Encryption Configurations",
+		Lister: listDiskEncryptionConfigurations,
+	}
+	return list.DataSource()
+}
+
+// listDiskEncryptionConfigurations returns the ID and name of every Jamf Pro disk encryption configuration.
+func listDiskEncryptionConfigurations(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetDiskEncryptionConfigurations()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.DiskEncryptionConfiguration))
+	for _, configuration := range response.DiskEncryptionConfiguration {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(configuration.ID), Name: configuration.Name})
+	}
+	return items, nil
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,9 +32,19 @@ func ResourceJamfProDiskEncryptionConfigurations() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			// The configuration itself carries no ID, so resolve the name from the list.
+			configurations, err := conn.GetDiskEncryptionConfigurations()
+			if err != nil {
+				return "", err
+			}
+			for _, configuration := range configurations.DiskEncryptionConfiguration {
+				if configuration.Name == name {
+					return strconv.Itoa(configuration.ID), nil
+				}
+			}
+			return "", nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -156,14 +168,14 @@ func ResourceJamfProDiskEncryptionConfigurationsCreate(ctx context.Context, d *s
 		var apiErr error
 		creationResponse, apiErr = conn.CreateDiskEncryptionConfiguration(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Disk Encryption Configuration '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Disk Encryption Configuration '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -215,7 +227,7 @@ func ResourceJamfProDiskEncryptionConfigurationsRead(ctx context.Context, d *sch
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -227,7 +239,7 @@ func ResourceJamfProDiskEncryptionConfigurationsRead(ctx context.Context, d *sch
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Disk Encryption Configuration with ID '%s'", resourceID))
 	}
 
 	// Assuming successful retrieval, proceed to set the resource attributes in Terraform state
@@ -296,14 +308,14 @@ func ResourceJamfProDiskEncryptionConfigurationsUpdate(ctx context.Context, d *s
 		_, apiErr := conn.UpdateDiskEncryptionConfigurationByID(resourceIDInt, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Disk Encryption Configuration '%s' (ID: %d) after retries: %v", resource.Name, resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Disk Encryption Configuration '%s' (ID: %d)", resource.Name, resourceIDInt))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -343,8 +355,8 @@ func ResourceJamfProDiskEncryptionConfigurationsDelete(ctx context.Context, d *s
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteDiskEncryptionConfigurationByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -352,7 +364,7 @@ func ResourceJamfProDiskEncryptionConfigurationsDelete(ctx context.Context, d *s
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Disk Encryption Configuration '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Disk Encryption Configuration '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -0,0 +1,34 @@
+// dockitems_list_data_source.go
+package dockitems
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProDockItemsList lists the Jamf Pro dock items, optionally filtered by name.
+func DataSourceJamfProDockItemsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Dock Items",
+		Lister: listDockItems,
+	}
+	return list.DataSource()
+}
+
+// listDockItems returns the ID and name of every Jamf Pro dock item.
+func listDockItems(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetDockItems()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.DockItems))
+	for _, item := range response.DockItems {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return items, nil
+}
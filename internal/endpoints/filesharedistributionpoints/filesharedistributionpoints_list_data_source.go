@@ -0,0 +1,47 @@
+// filesharedistributionpoints_list_data_source.go
+package filesharedistributionpoints
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriDistributionPoints = "/JSSResource/distributionpoints"
+
+// distributionPointsList is the Classic API list of file share distribution points. It is
+// declared here because the SDK's list type only holds the first distribution point.
+type distributionPointsList struct {
+	Size               int                                 `xml:"size"`
+	DistributionPoints []jamfpro.DistributionPointListItem `xml:"distribution_point"`
+}
+
+// DataSourceJamfProFileShareDistributionPointsList lists the Jamf Pro file share distribution points, optionally filtered by name.
+func DataSourceJamfProFileShareDistributionPointsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro File Share Distribution Points",
+		Lister: listFileShareDistributionPoints,
+	}
+	return list.DataSource()
+}
+
+// listFileShareDistributionPoints returns the ID and name of every Jamf Pro file share distribution point.
+func listFileShareDistributionPoints(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	var response distributionPointsList
+	resp, err := conn.HTTP.DoRequest("GET", uriDistributionPoints, nil, &response)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.DistributionPoints))
+	for _, distributionPoint := range response.DistributionPoints {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(distributionPoint.ID), Name: distributionPoint.Name})
+	}
+	return items, nil
+}
@@ -5,11 +5,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	util "github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/type_assertion"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
@@ -32,9 +33,13 @@ func ResourceJamfProFileShareDistributionPoints() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetDistributionPointByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -233,14 +238,14 @@ func ResourceJamfProFileShareDistributionPointsCreate(ctx context.Context, d *sc
 		var apiErr error
 		creationResponse, apiErr = conn.CreateDistributionPoint(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Fileshare Distribution Point '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Fileshare Distribution Point '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -292,7 +297,7 @@ func ResourceJamfProFileShareDistributionPointsRead(ctx context.Context, d *sche
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -304,7 +309,7 @@ func ResourceJamfProFileShareDistributionPointsRead(ctx context.Context, d *sche
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Fileshare Distribution Point with ID '%s'", resourceID))
 	}
 
 	// Check if distribution point data exists
@@ -314,6 +319,7 @@ func ResourceJamfProFileShareDistributionPointsRead(ctx context.Context, d *sche
 			"id":                    resourceID,
 			"name":                  resource.Name,
 			"ip_address":            resource.IPAddress,
+			"ipaddress":             resource.IP_Address,
 			"is_master":             resource.IsMaster,
 			"failover_point":        resource.FailoverPoint,
 			"failover_point_url":    resource.FailoverPointURL,
@@ -326,6 +332,7 @@ func ResourceJamfProFileShareDistributionPointsRead(ctx context.Context, d *sche
 			"workgroup_or_domain":              resource.WorkgroupOrDomain,
 			"share_port":                       resource.SharePort,
 			"read_only_username":               resource.ReadOnlyUsername,
+			"read_write_username":              resource.ReadWriteUsername,
 			"https_downloads_enabled":          resource.HTTPDownloadsEnabled,
 			"http_url":                         resource.HTTPURL,
 			"https_share_path":                 resource.Context,
@@ -378,14 +385,14 @@ func ResourceJamfProFileShareDistributionPointsUpdate(ctx context.Context, d *sc
 		_, apiErr := conn.UpdateDistributionPointByID(resourceIDInt, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro file share distribution point '%s' (ID: %d) after retries: %v", resource.Name, resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro file share distribution point '%s' (ID: %d)", resource.Name, resourceIDInt))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -425,8 +432,8 @@ func ResourceJamfProFileShareDistributionPointsDelete(ctx context.Context, d *sc
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteDistributionPointByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -434,7 +441,7 @@ func ResourceJamfProFileShareDistributionPointsDelete(ctx context.Context, d *sc
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro file share distribution point '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro file share distribution point '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
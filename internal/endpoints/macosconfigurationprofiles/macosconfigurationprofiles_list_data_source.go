@@ -0,0 +1,49 @@
+// macosconfigurationprofiles_list_data_source.go
+package macosconfigurationprofiles
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMacOSConfigurationProfilesList lists the Jamf Pro macOS configuration profiles, optionally filtered by name or site.
+func DataSourceJamfProMacOSConfigurationProfilesList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro macOS Configuration Profiles",
+		Lister:     listMacOSConfigurationProfiles,
+		SiteGetter: getMacOSConfigurationProfileSiteID,
+	}
+	return list.DataSource()
+}
+
+// listMacOSConfigurationProfiles returns the ID and name of every Jamf Pro macOS configuration profile.
+func listMacOSConfigurationProfiles(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetMacOSConfigurationProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, profile := range response.Results {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(profile.ID), Name: profile.Name})
+	}
+	return items, nil
+}
+
+// getMacOSConfigurationProfileSiteID returns the ID of the site a macOS configuration profile belongs to.
+func getMacOSConfigurationProfileSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetMacOSConfigurationProfileByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.General.Site), nil
+}
@@ -0,0 +1,111 @@
+// macosconfigurationprofiles_payload.go
+package macosconfigurationprofiles
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/utilities"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"howett.net/plist"
+)
+
+// serverManagedPayloadKeys are rewritten or injected by Jamf Pro when a profile is saved and are
+// ignored when comparing payloads.
+var serverManagedPayloadKeys = map[string]bool{
+	"PayloadUUID":         true,
+	"PayloadOrganization": true,
+}
+
+// uuidSuffix matches a UUID at the end of a PayloadIdentifier, on its own or after a dot.
+var uuidSuffix = regexp.MustCompile(`(^|\.)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`)
+
+// canonicalizePayload is the StateFunc for payload. It re-encodes the profile as an XML plist with
+// sorted keys and consistent indentation, so that a changed payload plans as a diff of the keys
+// that changed rather than of the whole document. Nothing is removed from the profile, and
+// payloads that are not valid plists are stored unchanged.
+func canonicalizePayload(val interface{}) string {
+	payload, _ := val.(string)
+
+	decoded, _, err := utilities.DecodePlistToMap([]byte(payload))
+	if err != nil {
+		return payload
+	}
+
+	encoded, err := utilities.EncodeMapToPlistString(decoded, plist.XMLFormat)
+	if err != nil {
+		return payload
+	}
+	return encoded
+}
+
+// suppressPayloadDiff is the DiffSuppressFunc for payload. It compares the two profiles as plists,
+// ignoring key order, whitespace, numeric type changes and the keys Jamf Pro manages itself.
+func suppressPayloadDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	oldPayload, ok := normalizePayload(old)
+	if !ok {
+		return false
+	}
+	newPayload, ok := normalizePayload(new)
+	if !ok {
+		return false
+	}
+
+	return reflect.DeepEqual(oldPayload, newPayload)
+}
+
+// normalizePayload decodes a profile and strips the parts of it that Jamf Pro rewrites on save.
+// It reports false when the payload is not a valid plist.
+func normalizePayload(payload string) (map[string]interface{}, bool) {
+	decoded, _, err := utilities.DecodePlistToMap([]byte(payload))
+	if err != nil || decoded == nil {
+		return nil, false
+	}
+
+	return normalizePayloadValue(decoded).(map[string]interface{}), true
+}
+
+// normalizePayloadValue returns a copy of value with server managed keys removed, UUID suffixes
+// trimmed from PayloadIdentifier and every number converted to float64.
+func normalizePayloadValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if serverManagedPayloadKeys[key] {
+				continue
+			}
+			if identifier, ok := item.(string); ok && key == "PayloadIdentifier" {
+				identifier = uuidSuffix.ReplaceAllString(strings.TrimSpace(identifier), "")
+				if identifier == "" {
+					continue
+				}
+				item = identifier
+			}
+			normalized[key] = normalizePayloadValue(item)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizePayloadValue(item)
+		}
+		return normalized
+	case string:
+		return strings.TrimSpace(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,347 @@
+// macosconfigurationprofiles_payload_data_source.go
+package macosconfigurationprofiles
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/cms"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/utilities"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"howett.net/plist"
+)
+
+// payloadUUIDNamespace is the RFC 4122 URL namespace, used to derive deterministic version 5
+// PayloadUUIDs from payload identifiers.
+var payloadUUIDNamespace = [16]byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+// DataSourceJamfProMacOSConfigurationProfilePayload builds a configuration profile document from
+// HCL, for use as the payload of a jamfpro_macos_configuration_profile. It does not call Jamf Pro.
+func DataSourceJamfProMacOSConfigurationProfilePayload() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceJamfProMacOSConfigurationProfilePayloadRead,
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PayloadDisplayName of the profile.",
+			},
+			"identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PayloadIdentifier of the profile, in reverse DNS form.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The PayloadUUID of the profile. Derived from identifier when not set, so that it stays the same across runs.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PayloadDescription of the profile.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PayloadOrganization of the profile, also applied to each payload.",
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The PayloadScope of the profile. One of 'System' or 'User'.",
+				ValidateFunc: validation.StringInSlice([]string{"System", "User"}, false),
+			},
+			"removal_disallowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The PayloadRemovalDisallowed value of the profile.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "The PayloadVersion of the profile.",
+			},
+			"content": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The payloads of the profile, in order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The PayloadType of the payload, e.g. 'com.apple.dock'.",
+						},
+						"identifier": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PayloadIdentifier of the payload. Defaults to the profile identifier followed by the payload type and, for repeated types, its position.",
+						},
+						"uuid": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PayloadUUID of the payload. Derived from the payload identifier when not set.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PayloadDisplayName of the payload.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PayloadDescription of the payload.",
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "The PayloadVersion of the payload.",
+						},
+						"settings": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The settings of the payload as a JSON object, usually written with jsonencode(). Objects become dictionaries, lists become arrays, whole numbers become integers and other numbers become reals.",
+							ValidateFunc: validation.StringIsJSON,
+						},
+					},
+				},
+			},
+			"signing_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A PEM encoded certificate, followed by any intermediate certificates, to sign the profile with.",
+				RequiredWith: []string{"signing_key"},
+			},
+			"signing_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "The PEM encoded RSA or ECDSA private key of signing_certificate.",
+				RequiredWith: []string{"signing_certificate"},
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unsigned profile as an XML property list, for the payload of a jamfpro_macos_configuration_profile.",
+			},
+			"signed_payload_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The profile signed as a CMS (PKCS #7) SignedData structure and base64 encoded. Only set when signing_certificate and signing_key are provided. RSA and ECDSA signatures are deterministic, so the value only changes when the payload, certificate or key changes.",
+			},
+		},
+	}
+}
+
+// DataSourceJamfProMacOSConfigurationProfilePayloadRead assembles the profile from the
+// configuration, encodes it as an XML property list and optionally signs it.
+func DataSourceJamfProMacOSConfigurationProfilePayloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	profile, profileUUID, err := constructProfilePayload(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payload, err := utilities.EncodeMapToPlistString(profile, plist.XMLFormat)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to encode configuration profile '%s': %v", d.Get("identifier").(string), err))
+	}
+
+	signedPayload := ""
+	if certPEM, ok := d.GetOk("signing_certificate"); ok {
+		signed, err := signProfilePayload([]byte(payload), certPEM.(string), d.Get("signing_key").(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to sign configuration profile '%s': %v", d.Get("identifier").(string), err))
+		}
+		signedPayload = base64.StdEncoding.EncodeToString(signed)
+	}
+
+	d.SetId(profileUUID)
+
+	if err := d.Set("uuid", profileUUID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("payload", payload); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("signed_payload_base64", signedPayload); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// constructProfilePayload builds the top level Configuration payload and returns it with its UUID.
+func constructProfilePayload(d *schema.ResourceData) (map[string]interface{}, string, error) {
+	identifier := d.Get("identifier").(string)
+	organization := d.Get("organization").(string)
+
+	profileUUID := d.Get("uuid").(string)
+	if profileUUID == "" {
+		profileUUID = deterministicPayloadUUID(identifier)
+	}
+
+	contents := d.Get("content").([]interface{})
+
+	// Count each payload type so that repeated types get distinct default identifiers
+	typeCounts := make(map[string]int)
+	for _, item := range contents {
+		typeCounts[item.(map[string]interface{})["type"].(string)]++
+	}
+
+	payloadContent := make([]interface{}, 0, len(contents))
+	seen := make(map[string]int)
+	for i, item := range contents {
+		content := item.(map[string]interface{})
+		payloadType := content["type"].(string)
+		seen[payloadType]++
+
+		payload := make(map[string]interface{})
+		if settings, _ := content["settings"].(string); settings != "" {
+			decoded, err := decodePayloadSettings(settings)
+			if err != nil {
+				return nil, "", fmt.Errorf("content %d (%s): %v", i, payloadType, err)
+			}
+			for key, value := range decoded {
+				if strings.HasPrefix(key, "Payload") {
+					return nil, "", fmt.Errorf("content %d (%s): settings must not contain %s, set it with the content block's attributes instead", i, payloadType, key)
+				}
+				payload[key] = value
+			}
+		}
+
+		payloadIdentifier, _ := content["identifier"].(string)
+		if payloadIdentifier == "" {
+			payloadIdentifier = identifier + "." + payloadType
+			if typeCounts[payloadType] > 1 {
+				payloadIdentifier = fmt.Sprintf("%s.%d", payloadIdentifier, seen[payloadType])
+			}
+		}
+
+		payloadUUID, _ := content["uuid"].(string)
+		if payloadUUID == "" {
+			payloadUUID = deterministicPayloadUUID(payloadIdentifier)
+		}
+
+		payload["PayloadType"] = payloadType
+		payload["PayloadIdentifier"] = payloadIdentifier
+		payload["PayloadUUID"] = payloadUUID
+		payload["PayloadVersion"] = content["version"].(int)
+		setIfNotEmpty(payload, "PayloadDisplayName", content["display_name"].(string))
+		setIfNotEmpty(payload, "PayloadDescription", content["description"].(string))
+		setIfNotEmpty(payload, "PayloadOrganization", organization)
+
+		payloadContent = append(payloadContent, payload)
+	}
+
+	profile := map[string]interface{}{
+		"PayloadContent":           payloadContent,
+		"PayloadDisplayName":       d.Get("display_name").(string),
+		"PayloadIdentifier":        identifier,
+		"PayloadRemovalDisallowed": d.Get("removal_disallowed").(bool),
+		"PayloadType":              "Configuration",
+		"PayloadUUID":              profileUUID,
+		"PayloadVersion":           d.Get("version").(int),
+	}
+	setIfNotEmpty(profile, "PayloadDescription", d.Get("description").(string))
+	setIfNotEmpty(profile, "PayloadOrganization", organization)
+	setIfNotEmpty(profile, "PayloadScope", d.Get("scope").(string))
+
+	return profile, profileUUID, nil
+}
+
+// decodePayloadSettings decodes a JSON object of payload settings, keeping whole numbers as
+// integers so that they encode as <integer> rather than <real>.
+func decodePayloadSettings(settings string) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(settings))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode settings: %v", err)
+	}
+
+	object, ok := convertJSONNumbers(decoded).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("settings must be a JSON object")
+	}
+	return object, nil
+}
+
+// convertJSONNumbers replaces json.Number values with int64 or float64 and drops nulls, which
+// property lists cannot represent.
+func convertJSONNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if item != nil {
+				converted[key] = convertJSONNumbers(item)
+			}
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if item != nil {
+				converted = append(converted, convertJSONNumbers(item))
+			}
+		}
+		return converted
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// deterministicPayloadUUID returns the upper case version 5 UUID of name, as used in PayloadUUID.
+func deterministicPayloadUUID(name string) string {
+	h := sha1.New()
+	h.Write(payloadUUIDNamespace[:])
+	h.Write([]byte(name))
+	sum := h.Sum(nil)
+
+	sum[6] = (sum[6] & 0x0f) | 0x50 // version 5
+	sum[8] = (sum[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return strings.ToUpper(fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16]))
+}
+
+// signProfilePayload signs the encoded profile with the PEM encoded certificate chain and key.
+func signProfilePayload(payload []byte, certPEM, keyPEM string) ([]byte, error) {
+	cert, chain, err := cms.ParseCertificateChain([]byte(certPEM))
+	if err != nil {
+		return nil, err
+	}
+	key, err := cms.ParsePrivateKey([]byte(keyPEM))
+	if err != nil {
+		return nil, err
+	}
+	return cms.Sign(payload, cert, chain, key)
+}
+
+// setIfNotEmpty sets key in m when value is not empty.
+func setIfNotEmpty(m map[string]interface{}, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
@@ -4,13 +4,16 @@ package macosconfigurationprofiles
 import (
 	"context"
 	"fmt"
+	"html"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,15 +29,23 @@ func ResourceJamfProMacOSConfigurationProfiles() *schema.Resource {
 		ReadContext:   ResourceJamfProMacOSConfigurationProfilesRead,
 		UpdateContext: ResourceJamfProMacOSConfigurationProfilesUpdate,
 		DeleteContext: ResourceJamfProMacOSConfigurationProfilesDelete,
+		CustomizeDiff: defaults.CustomizeDiff(
+			defaults.Field{Key: "site", Kind: defaults.Site, Format: defaults.Block, Unset: []interface{}{}},
+			defaults.Field{Key: "category", Kind: defaults.Category, Format: defaults.Block, Unset: []interface{}{}},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Second),
 			Read:   schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetMacOSConfigurationProfileByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.General.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 
 			"id": {
@@ -55,9 +66,9 @@ func ResourceJamfProMacOSConfigurationProfiles() *schema.Resource {
 			"site": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
-				Description: "The site to which the configuration profile is scoped.",
+				Description: "The site to which the configuration profile is scoped. Defaults to the provider's 'default_site' when not set.",
 				Optional:    true,
-				Default:     nil,
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -76,8 +87,9 @@ func ResourceJamfProMacOSConfigurationProfiles() *schema.Resource {
 			"category": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
-				Description: "The category to which the configuration profile is scoped.",
+				Description: "The category to which the configuration profile is scoped. Defaults to the provider's 'default_category' when not set.",
 				Optional:    true,
+				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -119,9 +131,11 @@ func ResourceJamfProMacOSConfigurationProfiles() *schema.Resource {
 				Description: "The UUID of the configuration profile.",
 			},
 			"payload": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "A MacOS configuration profile xml file as a file",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "A MacOS configuration profile xml file as a file. It is compared as a plist, ignoring key order, whitespace and the PayloadUUID, PayloadIdentifier UUID suffixes and PayloadOrganization that Jamf Pro rewrites on save.",
+				StateFunc:        canonicalizePayload,
+				DiffSuppressFunc: suppressPayloadDiff,
 			},
 			// "redeploy_on_update": { // TODO Review this, missing from the gui
 			// 	Type:        schema.TypeString,
@@ -449,13 +463,13 @@ func ResourceJamfProMacOSConfigurationProfilesCreate(ctx context.Context, d *sch
 		var apiErr error
 		creationResponse, apiErr = conn.CreateMacOSConfigurationProfile(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro macOS Configuration Profile '%s' after retries: %v", resource.General.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro macOS Configuration Profile '%s'", resource.General.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -511,10 +525,7 @@ func ResourceJamfProMacOSConfigurationProfilesRead(ctx context.Context, d *schem
 		var apiErr error
 		resp, apiErr = conn.GetMacOSConfigurationProfileByID(resourceIDInt)
 		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
@@ -523,7 +534,7 @@ func ResourceJamfProMacOSConfigurationProfilesRead(ctx context.Context, d *schem
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -535,25 +546,20 @@ func ResourceJamfProMacOSConfigurationProfilesRead(ctx context.Context, d *schem
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro macOS Configuration Profile with ID '%s'", resourceID))
 	}
 
-	// Stating - commented ones appear to be done automatically.
-
-	// ID
-	// if err := d.Set("id", resourceID); err != nil {
-	// 	diags = append(diags, diag.FromErr(err)...)
-	// }
-
-	// Name
-	// if err := d.Set("name", resp.General.Name); err != nil {
-	// 	diags = append(diags, diag.FromErr(err)...)
-	// }
-
-	// Description
-	// if err := d.Set("description", resp.General.Description); err != nil {
-	// 	diags = append(diags, diag.FromErr(err)...)
-	// }
+	// General
+	generalData := map[string]interface{}{
+		"name":        resp.General.Name,
+		"description": resp.General.Description,
+		"uuid":        resp.General.UUID,
+	}
+	for key, val := range generalData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
 
 	// Site
 	if resp.General.Site.ID != -1 && resp.General.Site.Name != "None" {
@@ -587,24 +593,24 @@ func ResourceJamfProMacOSConfigurationProfilesRead(ctx context.Context, d *schem
 	}
 
 	// Distribution Method
-	// if err := d.Set("distribution_method", resp.General.DistributionMethod); err != nil {
-	// 	diags = append(diags, diag.FromErr(err)...)
-	// }
+	if err := d.Set("distribution_method", resp.General.DistributionMethod); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 
 	// User Removeable
-	// if err := d.Set("user_removeable", resp.General.UserRemovable); err != nil {
-	// 	diags = append(diags, diag.FromErr(err)...)
-	// }
+	if err := d.Set("user_removeable", resp.General.UserRemovable); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 
 	// Level
-	// if err := d.Set("level", resp.General.Level); err != nil {
-	// 	diags = append(diags, diag.FromErr(err)...)
-	// }
+	if err := d.Set("level", resp.General.Level); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 
-	// UUID
-	// if err := d.Set("uuid", resp.General.UUID); err != nil {
-	// 	diags = append(diags, diag.FromErr(err)...)
-	// }
+	// Payload - Jamf Pro reformats the uploaded profile, which suppressPayloadDiff ignores
+	if err := d.Set("payload", canonicalizePayload(html.UnescapeString(resp.General.Payloads))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 
 	// Redeploy On Update - not in ui
 	// if err := d.Set("redeploy_on_update", resp.General.RedeployOnUpdate); err != nil {
@@ -885,13 +891,13 @@ func ResourceJamfProMacOSConfigurationProfilesUpdate(ctx context.Context, d *sch
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := conn.UpdateMacOSConfigurationProfileByID(resourceIDInt, resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro macOS Configuration Profile '%s' (ID: %d) after retries: %v", resource.General.Name, resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro macOS Configuration Profile '%s' (ID: %d)", resource.General.Name, resourceIDInt))
 	}
 
 	readDiags := ResourceJamfProMacOSConfigurationProfilesRead(ctx, d, meta)
@@ -926,14 +932,14 @@ func ResourceJamfProMacOSConfigurationProfilesDelete(ctx context.Context, d *sch
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteMacOSConfigurationProfileByName(resourceName)
 			if apiErrByName != nil {
-				return retry.RetryableError(apiErrByName)
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro macOS Configuration Profile '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro macOS Configuration Profile '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	d.SetId("")
@@ -0,0 +1,137 @@
+// mobiledeviceinventory_data_source.go
+package mobiledeviceinventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// mobileDeviceLookupKeys are the attributes a single mobile device can be looked up by.
+var mobileDeviceLookupKeys = []string{"id", "name", "serial_number", "udid"}
+
+// DataSourceJamfProMobileDeviceInventory provides information about a specific mobile device's inventory
+// by its ID, name, serial number or UDID.
+func DataSourceJamfProMobileDeviceInventory() *schema.Resource {
+	s := mobileDeviceInventorySectionsSchema()
+	s["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: mobileDeviceLookupKeys,
+		Description:  "The unique identifier of the mobile device.",
+	}
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The display name of the mobile device. Set from the response when the 'general' section is read.",
+	}
+	s["serial_number"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The serial number of the mobile device. Set from the response when the 'hardware' section is read.",
+	}
+	s["udid"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The UDID of the mobile device. Set from the response when the 'general' section is read.",
+	}
+	s["device_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the mobile device, such as 'iOS' or 'tvOS'.",
+	}
+	s["sections"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The inventory sections to set, such as 'general', 'hardware' or 'applications'. Every section is set when not set. Group memberships are read with a separate request, only when 'group_memberships' is among them.",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(mobileDeviceInventorySectionKeys, false),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceJamfProMobileDeviceInventoryRead,
+		Schema:      s,
+	}
+}
+
+// dataSourceJamfProMobileDeviceInventoryRead fetches the inventory of a specific mobile device from
+// Jamf Pro using its ID, name, serial number or UDID. Exactly one of them is set; a name, serial number
+// or UDID is resolved to an ID first, and an error is returned when no mobile device or more than one
+// mobile device matches it. Only the sections listed in 'sections' are set in the data source's state.
+func dataSourceJamfProMobileDeviceInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	lookupKey := "name"
+	lister := mobileDeviceLister("general.displayName", "general", d.Get("name").(string), func(device *mobileDeviceInventory) string {
+		return device.General.DisplayName
+	})
+	if serialNumber := d.Get("serial_number").(string); serialNumber != "" {
+		lookupKey = "serial_number"
+		lister = mobileDeviceLister("hardware.serialNumber", "hardware", serialNumber, func(device *mobileDeviceInventory) string {
+			return device.Hardware.SerialNumber
+		})
+	}
+	if udid := d.Get("udid").(string); udid != "" {
+		lookupKey = "udid"
+		lister = mobileDeviceLister("general.udid", "general", udid, func(device *mobileDeviceInventory) string {
+			return device.General.UDID
+		})
+	}
+
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Mobile Device", lookupKey, lister)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sections := configuredSections(d.Get("sections").([]interface{}), mobileDeviceInventorySectionKeys)
+
+	var device *mobileDeviceInventory
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		device, apiErr = getMobileDeviceInventoryByID(conn, resourceID, sections)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Mobile Device Inventory with ID '%s'", resourceID))
+	}
+
+	d.SetId(resourceID)
+	attributes := flattenMobileDeviceInventorySections(device, sections)
+	attributes["device_type"] = device.DeviceType
+	if hasSection(sections, "general") {
+		attributes["name"] = device.General.DisplayName
+		attributes["udid"] = device.General.UDID
+	}
+	if hasSection(sections, "hardware") {
+		attributes["serial_number"] = device.Hardware.SerialNumber
+	}
+
+	for key, value := range attributes {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting '%s' for Jamf Pro Mobile Device Inventory with ID '%s': %v", key, resourceID, err))
+		}
+	}
+
+	return nil
+}
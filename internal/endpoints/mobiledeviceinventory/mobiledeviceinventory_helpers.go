@@ -0,0 +1,164 @@
+// mobiledeviceinventory_helpers.go
+package mobiledeviceinventory
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+)
+
+const (
+	uriMobileDevicesV2 = "/api/v2/mobile-devices"
+
+	// listPageSize is the number of mobile devices requested per page when searching the inventory.
+	listPageSize = 100
+
+	// groupMembershipsSection is read from the Classic API rather than requested from the inventory.
+	groupMembershipsSection = "group_memberships"
+)
+
+// mobileDeviceInventorySectionKeys lists the sections of the mobile device inventory in the order
+// they are set. Each key is the name of the schema attribute the section is set on and, upper cased,
+// the section requested from the Jamf Pro API, except for group_memberships.
+var mobileDeviceInventorySectionKeys = []string{
+	"general",
+	"hardware",
+	"user_and_location",
+	"security",
+	"applications",
+	"profiles",
+	"extension_attributes",
+	groupMembershipsSection,
+}
+
+// configuredSections returns the section keys configured in the 'sections' attribute, or
+// defaults when none are configured.
+func configuredSections(configured []interface{}, defaults []string) []string {
+	if len(configured) == 0 {
+		return defaults
+	}
+	keys := make([]string, 0, len(configured))
+	for _, key := range configured {
+		keys = append(keys, key.(string))
+	}
+	return keys
+}
+
+// hasSection reports whether key is one of sections.
+func hasSection(sections []string, key string) bool {
+	for _, section := range sections {
+		if section == key {
+			return true
+		}
+	}
+	return false
+}
+
+// getMobileDeviceInventoryByID fetches the inventory of a mobile device by its ID, together with its
+// group memberships when they are among sections.
+func getMobileDeviceInventoryByID(conn *jamfpro.Client, id string, sections []string) (*mobileDeviceInventory, error) {
+	endpoint := fmt.Sprintf("%s/%s/detail", uriMobileDevicesV2, url.PathEscape(id))
+
+	var device mobileDeviceInventory
+	resp, err := conn.HTTP.DoRequest("GET", endpoint, nil, &device)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mobile device inventory by ID %s: %w", id, err)
+	}
+
+	if hasSection(sections, groupMembershipsSection) {
+		if err := getMobileDeviceGroups(conn, &device); err != nil {
+			return nil, err
+		}
+	}
+
+	return &device, nil
+}
+
+// getMobileDevicesInventory fetches the given sections of the inventory of every mobile device
+// matching the RSQL filter, in the order given by sort, requesting one page after another. An empty
+// filter matches every device and an empty sort leaves the order to Jamf Pro. Group memberships are
+// read for each device in turn when they are among sections.
+func getMobileDevicesInventory(conn *jamfpro.Client, filter, sort string, sections []string) ([]mobileDeviceInventory, error) {
+	var devices []mobileDeviceInventory
+	for page := 0; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("page-size", strconv.Itoa(listPageSize))
+		if filter != "" {
+			query.Set("filter", filter)
+		}
+		if sort != "" {
+			query.Set("sort", sort)
+		}
+		for _, key := range sections {
+			if key != groupMembershipsSection {
+				query.Add("section", strings.ToUpper(key))
+			}
+		}
+		endpoint := fmt.Sprintf("%s/detail?%s", uriMobileDevicesV2, query.Encode())
+
+		var list mobileDeviceInventoryList
+		resp, err := conn.HTTP.DoRequest("GET", endpoint, nil, &list)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to search mobile device inventory: %w", err)
+		}
+
+		devices = append(devices, list.Results...)
+		if len(list.Results) == 0 || len(devices) >= list.TotalCount {
+			break
+		}
+	}
+
+	if hasSection(sections, groupMembershipsSection) {
+		for i := range devices {
+			if err := getMobileDeviceGroups(conn, &devices[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return devices, nil
+}
+
+// getMobileDeviceGroups reads the groups a mobile device is a member of from the Classic API.
+func getMobileDeviceGroups(conn *jamfpro.Client, device *mobileDeviceInventory) error {
+	id, err := strconv.Atoi(device.MobileDeviceID)
+	if err != nil {
+		return fmt.Errorf("error converting mobile device ID '%s' to int: %v", device.MobileDeviceID, err)
+	}
+
+	subset, err := conn.GetMobileDeviceByIDAndDataSubset(id, "MobileDeviceGroups")
+	if err != nil {
+		return fmt.Errorf("failed to fetch the groups of mobile device %d: %w", id, err)
+	}
+
+	device.Groups = subset.MobileDeviceGroups
+	return nil
+}
+
+// mobileDeviceLister returns a lister for crud.DataSourceID that searches for mobile devices whose
+// field equals value, naming each device by that field as read by name.
+func mobileDeviceLister(field, section, value string, name func(device *mobileDeviceInventory) string) func(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	return func(conn *jamfpro.Client) ([]crud.ListItem, error) {
+		devices, err := getMobileDevicesInventory(conn, crud.RSQLEquals(field, value), "", []string{section})
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]crud.ListItem, 0, len(devices))
+		for i := range devices {
+			items = append(items, crud.ListItem{ID: devices[i].MobileDeviceID, Name: name(&devices[i])})
+		}
+		return items, nil
+	}
+}
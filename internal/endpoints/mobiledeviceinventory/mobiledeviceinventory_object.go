@@ -0,0 +1,134 @@
+// mobiledeviceinventory_object.go
+package mobiledeviceinventory
+
+import "github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+
+// mobileDeviceInventory is a mobile device as returned by the Jamf Pro API v2 mobile device
+// inventory, which the SDK does not cover. Only the sections exposed by the data sources are
+// decoded. Groups is read separately from the Classic API, since the inventory has no group section.
+type mobileDeviceInventory struct {
+	MobileDeviceID      string                            `json:"mobileDeviceId"`
+	DeviceType          string                            `json:"deviceType"`
+	General             mobileDeviceGeneral               `json:"general"`
+	Hardware            mobileDeviceHardware              `json:"hardware"`
+	UserAndLocation     mobileDeviceUserAndLocation       `json:"userAndLocation"`
+	Security            mobileDeviceSecurity              `json:"security"`
+	Applications        []mobileDeviceApplication         `json:"applications"`
+	Profiles            []mobileDeviceProfile             `json:"profiles"`
+	ExtensionAttributes []mobileDeviceExtensionAttribute  `json:"extensionAttributes"`
+	Groups              []jamfpro.MobileDeviceSubsetGroup `json:"-"`
+}
+
+// mobileDeviceInventoryList is a page of the mobile device inventory endpoint.
+type mobileDeviceInventoryList struct {
+	TotalCount int                     `json:"totalCount"`
+	Results    []mobileDeviceInventory `json:"results"`
+}
+
+type mobileDeviceGeneral struct {
+	UDID                                 string                           `json:"udid"`
+	DisplayName                          string                           `json:"displayName"`
+	AssetTag                             string                           `json:"assetTag"`
+	SiteID                               string                           `json:"siteId"`
+	LastInventoryUpdateDate              string                           `json:"lastInventoryUpdateDate"`
+	OSVersion                            string                           `json:"osVersion"`
+	OSRapidSecurityResponse              string                           `json:"osRapidSecurityResponse"`
+	OSBuild                              string                           `json:"osBuild"`
+	OSSupplementalBuildVersion           string                           `json:"osSupplementalBuildVersion"`
+	SoftwareUpdateDeviceID               string                           `json:"softwareUpdateDeviceId"`
+	IPAddress                            string                           `json:"ipAddress"`
+	Managed                              bool                             `json:"managed"`
+	Supervised                           bool                             `json:"supervised"`
+	DeviceOwnershipType                  string                           `json:"deviceOwnershipType"`
+	EnrolledViaAutomatedDeviceEnrollment bool                             `json:"enrolledViaAutomatedDeviceEnrollment"`
+	LastEnrolledDate                     string                           `json:"lastEnrolledDate"`
+	UserApprovedMdm                      bool                             `json:"userApprovedMdm"`
+	DeclarativeDeviceManagementEnabled   bool                             `json:"declarativeDeviceManagementEnabled"`
+	ManagementID                         string                           `json:"managementId"`
+	SharedIpad                           bool                             `json:"sharedIpad"`
+	TimeZone                             string                           `json:"timeZone"`
+	ItunesStoreAccountActive             bool                             `json:"itunesStoreAccountActive"`
+	CloudBackupEnabled                   bool                             `json:"cloudBackupEnabled"`
+	LastCloudBackupDate                  string                           `json:"lastCloudBackupDate"`
+	DeviceLocatorServiceEnabled          bool                             `json:"deviceLocatorServiceEnabled"`
+	DoNotDisturbEnabled                  bool                             `json:"doNotDisturbEnabled"`
+	ExtensionAttributes                  []mobileDeviceExtensionAttribute `json:"extensionAttributes"`
+}
+
+type mobileDeviceHardware struct {
+	CapacityMb                int                              `json:"capacityMb"`
+	AvailableSpaceMb          int                              `json:"availableSpaceMb"`
+	UsedSpacePercentage       int                              `json:"usedSpacePercentage"`
+	BatteryLevel              int                              `json:"batteryLevel"`
+	BatteryHealth             string                           `json:"batteryHealth"`
+	SerialNumber              string                           `json:"serialNumber"`
+	WifiMacAddress            string                           `json:"wifiMacAddress"`
+	BluetoothMacAddress       string                           `json:"bluetoothMacAddress"`
+	ModemFirmwareVersion      string                           `json:"modemFirmwareVersion"`
+	Model                     string                           `json:"model"`
+	ModelIdentifier           string                           `json:"modelIdentifier"`
+	ModelNumber               string                           `json:"modelNumber"`
+	BluetoothLowEnergyCapable bool                             `json:"bluetoothLowEnergyCapable"`
+	DeviceID                  string                           `json:"deviceId"`
+	ExtensionAttributes       []mobileDeviceExtensionAttribute `json:"extensionAttributes"`
+}
+
+type mobileDeviceUserAndLocation struct {
+	Username            string                           `json:"username"`
+	RealName            string                           `json:"realName"`
+	EmailAddress        string                           `json:"emailAddress"`
+	Position            string                           `json:"position"`
+	PhoneNumber         string                           `json:"phoneNumber"`
+	DepartmentID        string                           `json:"departmentId"`
+	BuildingID          string                           `json:"buildingId"`
+	Room                string                           `json:"room"`
+	ExtensionAttributes []mobileDeviceExtensionAttribute `json:"extensionAttributes"`
+}
+
+type mobileDeviceSecurity struct {
+	DataProtected                bool   `json:"dataProtected"`
+	BlockLevelEncryptionCapable  bool   `json:"blockLevelEncryptionCapable"`
+	FileLevelEncryptionCapable   bool   `json:"fileLevelEncryptionCapable"`
+	PasscodePresent              bool   `json:"passcodePresent"`
+	PasscodeCompliant            bool   `json:"passcodeCompliant"`
+	PasscodeCompliantWithProfile bool   `json:"passcodeCompliantWithProfile"`
+	HardwareEncryption           int    `json:"hardwareEncryption"`
+	ActivationLockEnabled        bool   `json:"activationLockEnabled"`
+	JailBreakDetected            bool   `json:"jailBreakDetected"`
+	PersonalDeviceProfileCurrent bool   `json:"personalDeviceProfileCurrent"`
+	LostModeEnabled              bool   `json:"lostModeEnabled"`
+	LostModePersistent           bool   `json:"lostModePersistent"`
+	LostModeMessage              string `json:"lostModeMessage"`
+	LostModePhoneNumber          string `json:"lostModePhoneNumber"`
+	LostModeFootnote             string `json:"lostModeFootnote"`
+}
+
+type mobileDeviceApplication struct {
+	Identifier       string `json:"identifier"`
+	Name             string `json:"name"`
+	Version          string `json:"version"`
+	ShortVersion     string `json:"shortVersion"`
+	ManagementStatus string `json:"managementStatus"`
+	ValidationStatus bool   `json:"validationStatus"`
+	BundleSize       string `json:"bundleSize"`
+	DynamicSize      string `json:"dynamicSize"`
+}
+
+type mobileDeviceProfile struct {
+	DisplayName   string `json:"displayName"`
+	Version       string `json:"version"`
+	UUID          string `json:"uuid"`
+	Identifier    string `json:"identifier"`
+	Removable     bool   `json:"removable"`
+	LastInstalled string `json:"lastInstalled"`
+	Username      string `json:"username"`
+}
+
+type mobileDeviceExtensionAttribute struct {
+	ID                                  string   `json:"id"`
+	Name                                string   `json:"name"`
+	Type                                string   `json:"type"`
+	Value                               []string `json:"value"`
+	ExtensionAttributeCollectionAllowed bool     `json:"extensionAttributeCollectionAllowed"`
+	InventoryDisplay                    string   `json:"inventoryDisplay"`
+}
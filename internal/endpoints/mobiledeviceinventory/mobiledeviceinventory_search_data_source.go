@@ -0,0 +1,134 @@
+// mobiledeviceinventory_search_data_source.go
+package mobiledeviceinventory
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultSearchSections are the sections read by jamfpro_mobile_devices_inventory when none are
+// configured, matching the Jamf Pro API, which only returns the general section unless asked for others.
+var defaultSearchSections = []string{"general"}
+
+// DataSourceJamfProMobileDevicesInventory searches the mobile device inventory with an RSQL filter,
+// returning the selected sections of every matching mobile device.
+func DataSourceJamfProMobileDevicesInventory() *schema.Resource {
+	device := mobileDeviceInventorySectionsSchema()
+	device["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier of the mobile device.",
+	}
+	device["device_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the mobile device, such as 'iOS' or 'tvOS'.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceJamfProMobileDevicesInventoryRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An RSQL query that mobile devices must match, such as 'general.supervised==true;hardware.model==\"iPad*\"'. Every mobile device matches when not set.",
+			},
+			"sort": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The sort criteria in the format 'property:asc' or 'property:desc', separated by commas, such as 'general.displayName:asc'. Jamf Pro sorts by ID when not set.",
+			},
+			"sections": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The inventory sections to read for each mobile device, such as 'general', 'hardware' or 'applications'. Only these sections are requested from Jamf Pro and set. Only 'general' is read when not set. 'group_memberships' is read with a separate request for each mobile device.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(mobileDeviceInventorySectionKeys, false),
+				},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the matching mobile devices, in the order returned by Jamf Pro.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"mobile_devices": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching mobile devices, in the order returned by Jamf Pro. Sections that were not requested are empty.",
+				Elem:        &schema.Resource{Schema: device},
+			},
+		},
+	}
+}
+
+// dataSourceJamfProMobileDevicesInventoryRead searches the Jamf Pro mobile device inventory, requesting
+// the configured sections of every mobile device that matches the filter one page at a time, and sets
+// them in the data source's state.
+func dataSourceJamfProMobileDevicesInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	filter := d.Get("filter").(string)
+	sort := d.Get("sort").(string)
+	sections := configuredSections(d.Get("sections").([]interface{}), defaultSearchSections)
+
+	var devices []mobileDeviceInventory
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		devices, apiErr = getMobileDevicesInventory(conn, filter, sort, sections)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to search Jamf Pro Mobile Device Inventory with filter '%s'", filter))
+	}
+
+	ids := make([]string, 0, len(devices))
+	flattened := make([]interface{}, 0, len(devices))
+	for i := range devices {
+		device := flattenMobileDeviceInventorySections(&devices[i], sections)
+		device["id"] = devices[i].MobileDeviceID
+		device["device_type"] = devices[i].DeviceType
+
+		ids = append(ids, devices[i].MobileDeviceID)
+		flattened = append(flattened, device)
+	}
+
+	log.Printf("[INFO] Found %d Jamf Pro mobile devices matching filter '%s'", len(devices), filter)
+
+	// The ID only has to be stable for a given search
+	search := strings.Join(append([]string{filter, sort}, sections...), "\x00")
+	d.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(search))), 10))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mobile_devices", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
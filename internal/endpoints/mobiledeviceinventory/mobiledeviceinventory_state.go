@@ -0,0 +1,325 @@
+// mobiledeviceinventory_state.go
+package mobiledeviceinventory
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mobileDeviceInventorySectionsSchema returns the computed attributes of the mobile device
+// inventory sections, shared by jamfpro_mobile_device_inventory and each device of
+// jamfpro_mobile_devices_inventory.
+func mobileDeviceInventorySectionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"general": computedSection("The 'general' section of the mobile device inventory.", map[string]*schema.Schema{
+			"udid":                          computed(schema.TypeString),
+			"display_name":                  computed(schema.TypeString),
+			"asset_tag":                     computed(schema.TypeString),
+			"site_id":                       computed(schema.TypeString),
+			"last_inventory_update_date":    computed(schema.TypeString),
+			"os_version":                    computed(schema.TypeString),
+			"os_rapid_security_response":    computed(schema.TypeString),
+			"os_build":                      computed(schema.TypeString),
+			"os_supplemental_build_version": computed(schema.TypeString),
+			"software_update_device_id":     computed(schema.TypeString),
+			"ip_address":                    computed(schema.TypeString),
+			"managed":                       computed(schema.TypeBool),
+			"supervised":                    computed(schema.TypeBool),
+			"device_ownership_type":         computed(schema.TypeString),
+			"enrolled_via_automated_device_enrollment": computed(schema.TypeBool),
+			"last_enrolled_date":                       computed(schema.TypeString),
+			"user_approved_mdm":                        computed(schema.TypeBool),
+			"declarative_device_management_enabled":    computed(schema.TypeBool),
+			"management_id":                            computed(schema.TypeString),
+			"shared_ipad":                              computed(schema.TypeBool),
+			"time_zone":                                computed(schema.TypeString),
+			"itunes_store_account_active":              computed(schema.TypeBool),
+			"cloud_backup_enabled":                     computed(schema.TypeBool),
+			"last_cloud_backup_date":                   computed(schema.TypeString),
+			"device_locator_service_enabled":           computed(schema.TypeBool),
+			"do_not_disturb_enabled":                   computed(schema.TypeBool),
+			"extension_attributes":                     extensionAttributesSchema(""),
+		}),
+		"hardware": computedSection("The 'hardware' section of the mobile device inventory.", map[string]*schema.Schema{
+			"capacity_mb":                  computed(schema.TypeInt),
+			"available_space_mb":           computed(schema.TypeInt),
+			"used_space_percentage":        computed(schema.TypeInt),
+			"battery_level":                computed(schema.TypeInt),
+			"battery_health":               computed(schema.TypeString),
+			"serial_number":                computed(schema.TypeString),
+			"wifi_mac_address":             computed(schema.TypeString),
+			"bluetooth_mac_address":        computed(schema.TypeString),
+			"modem_firmware_version":       computed(schema.TypeString),
+			"model":                        computed(schema.TypeString),
+			"model_identifier":             computed(schema.TypeString),
+			"model_number":                 computed(schema.TypeString),
+			"bluetooth_low_energy_capable": computed(schema.TypeBool),
+			"device_id":                    computed(schema.TypeString),
+			"extension_attributes":         extensionAttributesSchema(""),
+		}),
+		"user_and_location": computedSection("The 'userAndLocation' section of the mobile device inventory.", map[string]*schema.Schema{
+			"username":             computed(schema.TypeString),
+			"real_name":            computed(schema.TypeString),
+			"email_address":        computed(schema.TypeString),
+			"position":             computed(schema.TypeString),
+			"phone_number":         computed(schema.TypeString),
+			"department_id":        computed(schema.TypeString),
+			"building_id":          computed(schema.TypeString),
+			"room":                 computed(schema.TypeString),
+			"extension_attributes": extensionAttributesSchema(""),
+		}),
+		"security": computedSection("The 'security' section of the mobile device inventory.", map[string]*schema.Schema{
+			"data_protected":                  computed(schema.TypeBool),
+			"block_level_encryption_capable":  computed(schema.TypeBool),
+			"file_level_encryption_capable":   computed(schema.TypeBool),
+			"passcode_present":                computed(schema.TypeBool),
+			"passcode_compliant":              computed(schema.TypeBool),
+			"passcode_compliant_with_profile": computed(schema.TypeBool),
+			"hardware_encryption":             computed(schema.TypeInt),
+			"activation_lock_enabled":         computed(schema.TypeBool),
+			"jail_break_detected":             computed(schema.TypeBool),
+			"personal_device_profile_current": computed(schema.TypeBool),
+			"lost_mode_enabled":               computed(schema.TypeBool),
+			"lost_mode_persistent":            computed(schema.TypeBool),
+			"lost_mode_message":               computed(schema.TypeString),
+			"lost_mode_phone_number":          computed(schema.TypeString),
+			"lost_mode_footnote":              computed(schema.TypeString),
+		}),
+		"applications": computedSection("The apps installed on the mobile device.", map[string]*schema.Schema{
+			"identifier":        computed(schema.TypeString),
+			"name":              computed(schema.TypeString),
+			"version":           computed(schema.TypeString),
+			"short_version":     computed(schema.TypeString),
+			"management_status": computed(schema.TypeString),
+			"validation_status": computed(schema.TypeBool),
+			"bundle_size":       computed(schema.TypeString),
+			"dynamic_size":      computed(schema.TypeString),
+		}),
+		"profiles": computedSection("The configuration profiles installed on the mobile device.", map[string]*schema.Schema{
+			"display_name":   computed(schema.TypeString),
+			"version":        computed(schema.TypeString),
+			"uuid":           computed(schema.TypeString),
+			"identifier":     computed(schema.TypeString),
+			"removable":      computed(schema.TypeBool),
+			"last_installed": computed(schema.TypeString),
+			"username":       computed(schema.TypeString),
+		}),
+		"extension_attributes": extensionAttributesSchema("The extension attributes of the mobile device."),
+		"group_memberships": computedSection("The mobile device groups the device is a member of, read from the Classic API.", map[string]*schema.Schema{
+			"group_id":   computed(schema.TypeInt),
+			"group_name": computed(schema.TypeString),
+		}),
+	}
+}
+
+// computed returns a computed attribute of the given type.
+func computed(valueType schema.ValueType) *schema.Schema {
+	return &schema.Schema{
+		Type:     valueType,
+		Computed: true,
+	}
+}
+
+// computedSection returns a computed list of objects with the given attributes.
+func computedSection(description string, attributes map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem:        &schema.Resource{Schema: attributes},
+	}
+}
+
+// extensionAttributesSchema returns the computed list of extension attributes found in several sections.
+func extensionAttributesSchema(description string) *schema.Schema {
+	return computedSection(description, map[string]*schema.Schema{
+		"id":   computed(schema.TypeString),
+		"name": computed(schema.TypeString),
+		"type": computed(schema.TypeString),
+		"value": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"extension_attribute_collection_allowed": computed(schema.TypeBool),
+		"inventory_display":                      computed(schema.TypeString),
+	})
+}
+
+// flattenMobileDeviceInventorySections returns the requested sections of a mobile device keyed by schema name.
+func flattenMobileDeviceInventorySections(device *mobileDeviceInventory, sections []string) map[string]interface{} {
+	flattened := make(map[string]interface{})
+	for _, key := range sections {
+		switch key {
+		case "general":
+			flattened[key] = flattenGeneralSection(device.General)
+		case "hardware":
+			flattened[key] = flattenHardwareSection(device.Hardware)
+		case "user_and_location":
+			flattened[key] = flattenUserAndLocationSection(device.UserAndLocation)
+		case "security":
+			flattened[key] = flattenSecuritySection(device.Security)
+		case "applications":
+			flattened[key] = flattenApplicationsSection(device.Applications)
+		case "profiles":
+			flattened[key] = flattenProfilesSection(device.Profiles)
+		case "extension_attributes":
+			flattened[key] = flattenExtensionAttributes(device.ExtensionAttributes)
+		case groupMembershipsSection:
+			flattened[key] = flattenGroupMembershipsSection(device)
+		}
+	}
+	return flattened
+}
+
+// flattenGeneralSection maps the 'general' section of the mobile device inventory to the Terraform schema.
+func flattenGeneralSection(general mobileDeviceGeneral) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"udid":                          general.UDID,
+		"display_name":                  general.DisplayName,
+		"asset_tag":                     general.AssetTag,
+		"site_id":                       general.SiteID,
+		"last_inventory_update_date":    general.LastInventoryUpdateDate,
+		"os_version":                    general.OSVersion,
+		"os_rapid_security_response":    general.OSRapidSecurityResponse,
+		"os_build":                      general.OSBuild,
+		"os_supplemental_build_version": general.OSSupplementalBuildVersion,
+		"software_update_device_id":     general.SoftwareUpdateDeviceID,
+		"ip_address":                    general.IPAddress,
+		"managed":                       general.Managed,
+		"supervised":                    general.Supervised,
+		"device_ownership_type":         general.DeviceOwnershipType,
+		"enrolled_via_automated_device_enrollment": general.EnrolledViaAutomatedDeviceEnrollment,
+		"last_enrolled_date":                       general.LastEnrolledDate,
+		"user_approved_mdm":                        general.UserApprovedMdm,
+		"declarative_device_management_enabled":    general.DeclarativeDeviceManagementEnabled,
+		"management_id":                            general.ManagementID,
+		"shared_ipad":                              general.SharedIpad,
+		"time_zone":                                general.TimeZone,
+		"itunes_store_account_active":              general.ItunesStoreAccountActive,
+		"cloud_backup_enabled":                     general.CloudBackupEnabled,
+		"last_cloud_backup_date":                   general.LastCloudBackupDate,
+		"device_locator_service_enabled":           general.DeviceLocatorServiceEnabled,
+		"do_not_disturb_enabled":                   general.DoNotDisturbEnabled,
+		"extension_attributes":                     flattenExtensionAttributes(general.ExtensionAttributes),
+	}}
+}
+
+// flattenHardwareSection maps the 'hardware' section of the mobile device inventory to the Terraform schema.
+func flattenHardwareSection(hardware mobileDeviceHardware) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"capacity_mb":                  hardware.CapacityMb,
+		"available_space_mb":           hardware.AvailableSpaceMb,
+		"used_space_percentage":        hardware.UsedSpacePercentage,
+		"battery_level":                hardware.BatteryLevel,
+		"battery_health":               hardware.BatteryHealth,
+		"serial_number":                hardware.SerialNumber,
+		"wifi_mac_address":             hardware.WifiMacAddress,
+		"bluetooth_mac_address":        hardware.BluetoothMacAddress,
+		"modem_firmware_version":       hardware.ModemFirmwareVersion,
+		"model":                        hardware.Model,
+		"model_identifier":             hardware.ModelIdentifier,
+		"model_number":                 hardware.ModelNumber,
+		"bluetooth_low_energy_capable": hardware.BluetoothLowEnergyCapable,
+		"device_id":                    hardware.DeviceID,
+		"extension_attributes":         flattenExtensionAttributes(hardware.ExtensionAttributes),
+	}}
+}
+
+// flattenUserAndLocationSection maps the 'userAndLocation' section of the mobile device inventory to the Terraform schema.
+func flattenUserAndLocationSection(userAndLocation mobileDeviceUserAndLocation) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"username":             userAndLocation.Username,
+		"real_name":            userAndLocation.RealName,
+		"email_address":        userAndLocation.EmailAddress,
+		"position":             userAndLocation.Position,
+		"phone_number":         userAndLocation.PhoneNumber,
+		"department_id":        userAndLocation.DepartmentID,
+		"building_id":          userAndLocation.BuildingID,
+		"room":                 userAndLocation.Room,
+		"extension_attributes": flattenExtensionAttributes(userAndLocation.ExtensionAttributes),
+	}}
+}
+
+// flattenSecuritySection maps the 'security' section of the mobile device inventory to the Terraform schema.
+func flattenSecuritySection(security mobileDeviceSecurity) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"data_protected":                  security.DataProtected,
+		"block_level_encryption_capable":  security.BlockLevelEncryptionCapable,
+		"file_level_encryption_capable":   security.FileLevelEncryptionCapable,
+		"passcode_present":                security.PasscodePresent,
+		"passcode_compliant":              security.PasscodeCompliant,
+		"passcode_compliant_with_profile": security.PasscodeCompliantWithProfile,
+		"hardware_encryption":             security.HardwareEncryption,
+		"activation_lock_enabled":         security.ActivationLockEnabled,
+		"jail_break_detected":             security.JailBreakDetected,
+		"personal_device_profile_current": security.PersonalDeviceProfileCurrent,
+		"lost_mode_enabled":               security.LostModeEnabled,
+		"lost_mode_persistent":            security.LostModePersistent,
+		"lost_mode_message":               security.LostModeMessage,
+		"lost_mode_phone_number":          security.LostModePhoneNumber,
+		"lost_mode_footnote":              security.LostModeFootnote,
+	}}
+}
+
+// flattenApplicationsSection maps the 'applications' section of the mobile device inventory to the Terraform schema.
+func flattenApplicationsSection(applications []mobileDeviceApplication) []interface{} {
+	apps := make([]interface{}, len(applications))
+	for i, app := range applications {
+		apps[i] = map[string]interface{}{
+			"identifier":        app.Identifier,
+			"name":              app.Name,
+			"version":           app.Version,
+			"short_version":     app.ShortVersion,
+			"management_status": app.ManagementStatus,
+			"validation_status": app.ValidationStatus,
+			"bundle_size":       app.BundleSize,
+			"dynamic_size":      app.DynamicSize,
+		}
+	}
+	return apps
+}
+
+// flattenProfilesSection maps the 'profiles' section of the mobile device inventory to the Terraform schema.
+func flattenProfilesSection(profiles []mobileDeviceProfile) []interface{} {
+	profileList := make([]interface{}, len(profiles))
+	for i, profile := range profiles {
+		profileList[i] = map[string]interface{}{
+			"display_name":   profile.DisplayName,
+			"version":        profile.Version,
+			"uuid":           profile.UUID,
+			"identifier":     profile.Identifier,
+			"removable":      profile.Removable,
+			"last_installed": profile.LastInstalled,
+			"username":       profile.Username,
+		}
+	}
+	return profileList
+}
+
+// flattenExtensionAttributes maps a list of mobile device extension attributes to the Terraform schema.
+func flattenExtensionAttributes(extensionAttributes []mobileDeviceExtensionAttribute) []interface{} {
+	attributes := make([]interface{}, len(extensionAttributes))
+	for i, attribute := range extensionAttributes {
+		attributes[i] = map[string]interface{}{
+			"id":                                     attribute.ID,
+			"name":                                   attribute.Name,
+			"type":                                   attribute.Type,
+			"value":                                  attribute.Value,
+			"extension_attribute_collection_allowed": attribute.ExtensionAttributeCollectionAllowed,
+			"inventory_display":                      attribute.InventoryDisplay,
+		}
+	}
+	return attributes
+}
+
+// flattenGroupMembershipsSection maps the groups of a mobile device to the Terraform schema.
+func flattenGroupMembershipsSection(device *mobileDeviceInventory) []interface{} {
+	groups := make([]interface{}, len(device.Groups))
+	for i, group := range device.Groups {
+		groups[i] = map[string]interface{}{
+			"group_id":   group.ID,
+			"group_name": group.Name,
+		}
+	}
+	return groups
+}
@@ -0,0 +1,117 @@
+// mobiledeviceprestageenrollments_data_source.go
+package mobiledeviceprestageenrollments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDevicePrestageEnrollment provides information about a specific mobile device prestage in Jamf Pro.
+func DataSourceJamfProMobileDevicePrestageEnrollment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceJamfProMobileDevicePrestageEnrollmentRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "display_name"},
+				Description:  "The unique identifier of the mobile device prestage.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The display name of the mobile device prestage.",
+			},
+			"device_enrollment_program_instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The device enrollment program instance ID.",
+			},
+			"supervised": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the device is supervised.",
+			},
+			"multi_user": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the device is enrolled as a Shared iPad.",
+			},
+			"profile_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The profile UUID.",
+			},
+		},
+	}
+}
+
+// DataSourceJamfProMobileDevicePrestageEnrollmentRead fetches the details of a specific mobile device prestage from Jamf Pro using its unique ID or name.
+func DataSourceJamfProMobileDevicePrestageEnrollmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Initialize API client
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	// Initialize variables
+	var diags diag.Diagnostics
+	resourceID, err := crud.DataSourceID(ctx, d, meta, "Jamf Pro Mobile Device Prestage", "display_name", listMobileDevicePrestageEnrollments)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resource *mobileDevicePrestage
+
+	// Read operation with retry
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = getMobileDevicePrestageByID(conn, resourceID)
+		if apiErr != nil {
+			// Retry transient API errors, stop on permanent ones
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		// Successfully read the mobile device prestage, exit the retry loop
+		return nil
+	})
+
+	if err != nil {
+		// Handle the final error after all retries have been exhausted
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro mobile device prestage enrollment with ID '%s'", resourceID))
+	}
+
+	// Check if resource data exists and set the Terraform state
+	if resource != nil {
+		d.SetId(resourceID) // Confirm the ID in the Terraform state
+		attributes := map[string]interface{}{
+			"display_name":                          resource.DisplayName,
+			"device_enrollment_program_instance_id": resource.DeviceEnrollmentProgramInstanceID,
+			"supervised":                            resource.Supervised,
+			"multi_user":                            resource.MultiUser,
+			"profile_uuid":                          resource.ProfileUUID,
+		}
+		for key, val := range attributes {
+			if err := d.Set(key, val); err != nil {
+				diags = append(diags, diag.FromErr(fmt.Errorf("error setting '%s' for Jamf Pro mobile device prestage enrollment with ID '%s': %v", key, resourceID, err))...)
+			}
+		}
+	} else {
+		d.SetId("") // Data not found, unset the ID in the Terraform state
+	}
+
+	return diags
+}
@@ -0,0 +1,137 @@
+// mobiledeviceprestageenrollments_helpers.go
+package mobiledeviceprestageenrollments
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	uriMobileDevicePrestagesV2 = "/api/v2/mobile-device-prestages"
+
+	// listPageSize is the number of prestages requested per page when listing them.
+	listPageSize = 100
+)
+
+// mobileDevicePrestagesList is a page of the mobile device prestages list endpoint.
+type mobileDevicePrestagesList struct {
+	TotalCount int                    `json:"totalCount"`
+	Results    []mobileDevicePrestage `json:"results"`
+}
+
+// mobileDevicePrestageCreated is the response to creating a mobile device prestage.
+type mobileDevicePrestageCreated struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+// unversionedPrestageFields are skipped when looking for changes made outside Terraform, since
+// version locks change on every save.
+var unversionedPrestageFields = map[string]bool{
+	"id":           true,
+	"version_lock": true,
+}
+
+// getMobileDevicePrestageByID fetches a mobile device prestage by its ID.
+func getMobileDevicePrestageByID(conn *jamfpro.Client, id string) (*mobileDevicePrestage, error) {
+	endpoint := fmt.Sprintf("%s/%s", uriMobileDevicePrestagesV2, id)
+
+	var prestage mobileDevicePrestage
+	resp, err := conn.HTTP.DoRequest("GET", endpoint, nil, &prestage)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mobile device prestage by ID '%s': %w", id, err)
+	}
+
+	return &prestage, nil
+}
+
+// getMobileDevicePrestages pages through and returns every mobile device prestage.
+func getMobileDevicePrestages(conn *jamfpro.Client) ([]mobileDevicePrestage, error) {
+	var prestages []mobileDevicePrestage
+	for page := 0; ; page++ {
+		endpoint := fmt.Sprintf("%s?page=%d&page-size=%d", uriMobileDevicePrestagesV2, page, listPageSize)
+
+		var list mobileDevicePrestagesList
+		resp, err := conn.HTTP.DoRequest("GET", endpoint, nil, &list)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list mobile device prestages: %w", err)
+		}
+
+		prestages = append(prestages, list.Results...)
+		if len(list.Results) == 0 || len(prestages) >= list.TotalCount {
+			return prestages, nil
+		}
+	}
+}
+
+// getMobileDevicePrestageByName returns the mobile device prestage with the given display name.
+func getMobileDevicePrestageByName(conn *jamfpro.Client, name string) (*mobileDevicePrestage, error) {
+	prestages, err := getMobileDevicePrestages(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range prestages {
+		if prestages[i].DisplayName == name {
+			return &prestages[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no mobile device prestage named '%s' was found", name)
+}
+
+// createMobileDevicePrestage creates a mobile device prestage and returns its ID.
+func createMobileDevicePrestage(conn *jamfpro.Client, prestage *mobileDevicePrestage) (string, error) {
+	var created mobileDevicePrestageCreated
+	resp, err := conn.HTTP.DoRequest("POST", uriMobileDevicePrestagesV2, prestage, &created)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create mobile device prestage '%s': %w", prestage.DisplayName, err)
+	}
+
+	return created.ID, nil
+}
+
+// updateMobileDevicePrestageByID replaces the mobile device prestage with the given ID.
+func updateMobileDevicePrestageByID(conn *jamfpro.Client, id string, prestage *mobileDevicePrestage) error {
+	endpoint := fmt.Sprintf("%s/%s", uriMobileDevicePrestagesV2, id)
+
+	var updated mobileDevicePrestage
+	resp, err := conn.HTTP.DoRequest("PUT", endpoint, prestage, &updated)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update mobile device prestage by ID '%s': %w", id, err)
+	}
+
+	return nil
+}
+
+// applyCurrentVersionLocks copies the version locks of the prestage currently held by Jamf Pro
+// onto resource, so that the update is not rejected for carrying the stale locks from state.
+func applyCurrentVersionLocks(resource, current *mobileDevicePrestage) {
+	resource.VersionLock = current.VersionLock
+	resource.LocationInformation.VersionLock = current.LocationInformation.VersionLock
+	resource.PurchasingInformation.VersionLock = current.PurchasingInformation.VersionLock
+}
+
+// remotelyChangedFields returns the attributes of the prestage that were changed outside Terraform.
+func remotelyChangedFields(d *schema.ResourceData, current *mobileDevicePrestage) []string {
+	state := func(remote *schema.ResourceData) diag.Diagnostics {
+		return updateTerraformState(remote, current)
+	}
+	return crud.RemotelyChangedFields(d, ResourceJamfProMobileDevicePrestageEnrollment(), state, unversionedPrestageFields)
+}
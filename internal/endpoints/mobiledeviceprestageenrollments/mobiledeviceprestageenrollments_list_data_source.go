@@ -0,0 +1,45 @@
+// mobiledeviceprestageenrollments_list_data_source.go
+package mobiledeviceprestageenrollments
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDevicePrestageEnrollmentsList lists the Jamf Pro mobile device prestage enrollments, optionally filtered by name or site.
+func DataSourceJamfProMobileDevicePrestageEnrollmentsList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Mobile Device Prestage Enrollments",
+		Lister:     listMobileDevicePrestageEnrollments,
+		SiteGetter: getMobileDevicePrestageSiteID,
+	}
+	return list.DataSource()
+}
+
+// listMobileDevicePrestageEnrollments returns the ID and name of every Jamf Pro mobile device prestage.
+func listMobileDevicePrestageEnrollments(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	prestages, err := getMobileDevicePrestages(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(prestages))
+	for _, prestage := range prestages {
+		items = append(items, crud.ListItem{ID: prestage.ID, Name: prestage.DisplayName})
+	}
+	return items, nil
+}
+
+// getMobileDevicePrestageSiteID returns the ID of the site a mobile device prestage belongs to.
+func getMobileDevicePrestageSiteID(conn *jamfpro.Client, id string) (string, error) {
+	resource, err := getMobileDevicePrestageByID(conn, id)
+	if err != nil {
+		return "", err
+	}
+	if resource.SiteID == "" {
+		return "-1", nil
+	}
+	return resource.SiteID, nil
+}
@@ -0,0 +1,288 @@
+// mobiledeviceprestageenrollments_object.go
+package mobiledeviceprestageenrollments
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mobileDevicePrestage is the Jamf Pro API v2 mobile device prestage. It is declared here rather
+// than taken from the SDK, whose model lacks most skip setup items, the installed profiles and a
+// separate purchasing information type.
+type mobileDevicePrestage struct {
+	ID                                  string                                    `json:"id,omitempty"`
+	DisplayName                         string                                    `json:"displayName"`
+	Mandatory                           bool                                      `json:"mandatory"`
+	MDMRemovable                        bool                                      `json:"mdmRemovable"`
+	SupportPhoneNumber                  string                                    `json:"supportPhoneNumber"`
+	SupportEmailAddress                 string                                    `json:"supportEmailAddress"`
+	Department                          string                                    `json:"department"`
+	DefaultPrestage                     bool                                      `json:"defaultPrestage"`
+	EnrollmentSiteID                    string                                    `json:"enrollmentSiteId"`
+	KeepExistingSiteMembership          bool                                      `json:"keepExistingSiteMembership"`
+	KeepExistingLocationInformation     bool                                      `json:"keepExistingLocationInformation"`
+	RequireAuthentication               bool                                      `json:"requireAuthentication"`
+	AuthenticationPrompt                string                                    `json:"authenticationPrompt"`
+	PreventActivationLock               bool                                      `json:"preventActivationLock"`
+	EnableDeviceBasedActivationLock     bool                                      `json:"enableDeviceBasedActivationLock"`
+	DeviceEnrollmentProgramInstanceID   string                                    `json:"deviceEnrollmentProgramInstanceId"`
+	SkipSetupItems                      map[string]bool                           `json:"skipSetupItems"`
+	LocationInformation                 mobileDevicePrestageLocationInformation   `json:"locationInformation"`
+	PurchasingInformation               mobileDevicePrestagePurchasingInformation `json:"purchasingInformation"`
+	AnchorCertificates                  []string                                  `json:"anchorCertificates"`
+	EnrollmentCustomizationID           string                                    `json:"enrollmentCustomizationId"`
+	Language                            string                                    `json:"language"`
+	Region                              string                                    `json:"region"`
+	AutoAdvanceSetup                    bool                                      `json:"autoAdvanceSetup"`
+	AllowPairing                        bool                                      `json:"allowPairing"`
+	MultiUser                           bool                                      `json:"multiUser"`
+	Supervised                          bool                                      `json:"supervised"`
+	MaximumSharedAccounts               int                                       `json:"maximumSharedAccounts"`
+	ConfigureDeviceBeforeSetupAssistant bool                                      `json:"configureDeviceBeforeSetupAssistant"`
+	Names                               mobileDevicePrestageNames                 `json:"names"`
+	SendTimezone                        bool                                      `json:"sendTimezone"`
+	Timezone                            string                                    `json:"timezone"`
+	StorageQuotaSizeMegabytes           int                                       `json:"storageQuotaSizeMegabytes"`
+	UseStorageQuotaSize                 bool                                      `json:"useStorageQuotaSize"`
+	TemporarySessionOnly                bool                                      `json:"temporarySessionOnly"`
+	EnforceTemporarySessionTimeout      bool                                      `json:"enforceTemporarySessionTimeout"`
+	TemporarySessionTimeout             int                                       `json:"temporarySessionTimeout"`
+	EnforceUserSessionTimeout           bool                                      `json:"enforceUserSessionTimeout"`
+	UserSessionTimeout                  int                                       `json:"userSessionTimeout"`
+	PrestageInstalledProfileIDs         []string                                  `json:"prestageInstalledProfileIds"`
+	ProfileUUID                         string                                    `json:"profileUuid"`
+	SiteID                              string                                    `json:"siteId"`
+	VersionLock                         int                                       `json:"versionLock"`
+}
+
+type mobileDevicePrestageLocationInformation struct {
+	ID           string `json:"id"`
+	Username     string `json:"username"`
+	Realname     string `json:"realname"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`
+	Room         string `json:"room"`
+	Position     string `json:"position"`
+	DepartmentID string `json:"departmentId"`
+	BuildingID   string `json:"buildingId"`
+	VersionLock  int    `json:"versionLock"`
+}
+
+type mobileDevicePrestagePurchasingInformation struct {
+	ID                string `json:"id"`
+	Leased            bool   `json:"leased"`
+	Purchased         bool   `json:"purchased"`
+	AppleCareID       string `json:"appleCareId"`
+	PONumber          string `json:"poNumber"`
+	Vendor            string `json:"vendor"`
+	PurchasePrice     string `json:"purchasePrice"`
+	LifeExpectancy    int    `json:"lifeExpectancy"`
+	PurchasingAccount string `json:"purchasingAccount"`
+	PurchasingContact string `json:"purchasingContact"`
+	LeaseDate         string `json:"leaseDate"`
+	PODate            string `json:"poDate"`
+	WarrantyDate      string `json:"warrantyDate"`
+	VersionLock       int    `json:"versionLock"`
+}
+
+type mobileDevicePrestageNames struct {
+	AssignNamesUsing       string                           `json:"assignNamesUsing"`
+	PrestageDeviceNames    []mobileDevicePrestageDeviceName `json:"prestageDeviceNames"`
+	DeviceNamePrefix       string                           `json:"deviceNamePrefix"`
+	DeviceNameSuffix       string                           `json:"deviceNameSuffix"`
+	SingleDeviceName       string                           `json:"singleDeviceName"`
+	ManageNames            bool                             `json:"manageNames"`
+	DeviceNamingConfigured bool                             `json:"deviceNamingConfigured"`
+}
+
+type mobileDevicePrestageDeviceName struct {
+	ID         string `json:"id,omitempty"`
+	DeviceName string `json:"deviceName"`
+	Used       bool   `json:"used"`
+}
+
+// skipSetupItemKeys maps the attributes of skip_setup_items to the Setup Assistant pane keys used
+// by the Jamf Pro API.
+var skipSetupItemKeys = map[string]string{
+	"accessibility_appearance": "AccessibilityAppearance",
+	"action_button":            "ActionButton",
+	"appearance":               "Appearance",
+	"apple_id":                 "AppleID",
+	"biometric":                "Biometric",
+	"diagnostics":              "Diagnostics",
+	"display_tone":             "DisplayTone",
+	"enable_lockdown_mode":     "EnableLockdownMode",
+	"express_language":         "ExpressLanguage",
+	"home_button_sensitivity":  "HomeButtonSensitivity",
+	"icloud_storage":           "CloudStorage",
+	"imessage_and_facetime":    "IMessageAndFaceTime",
+	"intelligence":             "Intelligence",
+	"keyboard":                 "Keyboard",
+	"location":                 "Location",
+	"passcode":                 "Passcode",
+	"payment":                  "Payment",
+	"preferred_language":       "PreferredLanguage",
+	"privacy":                  "Privacy",
+	"restore":                  "Restore",
+	"restore_completed":        "RestoreCompleted",
+	"safety":                   "Safety",
+	"screen_saver":             "ScreenSaver",
+	"screen_time":              "ScreenTime",
+	"sim_setup":                "SIMSetup",
+	"siri":                     "Siri",
+	"software_update":          "SoftwareUpdate",
+	"terms_of_address":         "TermsOfAddress",
+	"transfer_data":            "TransferData",
+	"tv_home_screen_sync":      "TVHomeScreenSync",
+	"tv_provider_sign_in":      "TVProviderSignIn",
+	"tv_room":                  "TVRoom",
+	"update_completed":         "UpdateCompleted",
+	"voice_selection":          "VoiceSelection",
+	"welcome":                  "Welcome",
+	"zoom":                     "Zoom",
+}
+
+// constructJamfProMobileDevicePrestageEnrollment constructs a mobile device prestage from the provided schema data.
+func constructJamfProMobileDevicePrestageEnrollment(d *schema.ResourceData) (*mobileDevicePrestage, error) {
+	prestage := &mobileDevicePrestage{
+		DisplayName:                         d.Get("display_name").(string),
+		Mandatory:                           d.Get("mandatory").(bool),
+		MDMRemovable:                        d.Get("mdm_removable").(bool),
+		SupportPhoneNumber:                  d.Get("support_phone_number").(string),
+		SupportEmailAddress:                 d.Get("support_email_address").(string),
+		Department:                          d.Get("department").(string),
+		DefaultPrestage:                     d.Get("default_prestage").(bool),
+		EnrollmentSiteID:                    d.Get("enrollment_site_id").(string),
+		KeepExistingSiteMembership:          d.Get("keep_existing_site_membership").(bool),
+		KeepExistingLocationInformation:     d.Get("keep_existing_location_information").(bool),
+		RequireAuthentication:               d.Get("require_authentication").(bool),
+		AuthenticationPrompt:                d.Get("authentication_prompt").(string),
+		PreventActivationLock:               d.Get("prevent_activation_lock").(bool),
+		EnableDeviceBasedActivationLock:     d.Get("enable_device_based_activation_lock").(bool),
+		DeviceEnrollmentProgramInstanceID:   d.Get("device_enrollment_program_instance_id").(string),
+		EnrollmentCustomizationID:           d.Get("enrollment_customization_id").(string),
+		Language:                            d.Get("language").(string),
+		Region:                              d.Get("region").(string),
+		AutoAdvanceSetup:                    d.Get("auto_advance_setup").(bool),
+		AllowPairing:                        d.Get("allow_pairing").(bool),
+		MultiUser:                           d.Get("multi_user").(bool),
+		Supervised:                          d.Get("supervised").(bool),
+		MaximumSharedAccounts:               d.Get("maximum_shared_accounts").(int),
+		ConfigureDeviceBeforeSetupAssistant: d.Get("configure_device_before_setup_assistant").(bool),
+		SendTimezone:                        d.Get("send_timezone").(bool),
+		Timezone:                            d.Get("timezone").(string),
+		StorageQuotaSizeMegabytes:           d.Get("storage_quota_size_megabytes").(int),
+		UseStorageQuotaSize:                 d.Get("use_storage_quota_size").(bool),
+		TemporarySessionOnly:                d.Get("temporary_session_only").(bool),
+		EnforceTemporarySessionTimeout:      d.Get("enforce_temporary_session_timeout").(bool),
+		TemporarySessionTimeout:             d.Get("temporary_session_timeout").(int),
+		EnforceUserSessionTimeout:           d.Get("enforce_user_session_timeout").(bool),
+		UserSessionTimeout:                  d.Get("user_session_timeout").(int),
+		ProfileUUID:                         d.Get("profile_uuid").(string),
+		SiteID:                              d.Get("site_id").(string),
+		VersionLock:                         d.Get("version_lock").(int),
+		AnchorCertificates:                  stringList(d.Get("anchor_certificates")),
+		PrestageInstalledProfileIDs:         stringList(d.Get("prestage_installed_profile_ids")),
+	}
+
+	// Every skip setup item is sent, so that clearing one in configuration shows the pane again
+	prestage.SkipSetupItems = make(map[string]bool, len(skipSetupItemKeys))
+	skipSetupItems := map[string]interface{}{}
+	if v, ok := d.GetOk("skip_setup_items"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		skipSetupItems = v.([]interface{})[0].(map[string]interface{})
+	}
+	for attribute, key := range skipSetupItemKeys {
+		skip, _ := skipSetupItems[attribute].(bool)
+		prestage.SkipSetupItems[key] = skip
+	}
+
+	if v, ok := d.GetOk("location_information"); ok && len(v.([]interface{})) > 0 {
+		prestage.LocationInformation = constructLocationInformation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("purchasing_information"); ok && len(v.([]interface{})) > 0 {
+		prestage.PurchasingInformation = constructPurchasingInformation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("names"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		prestage.Names = constructNames(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	// Serialize and pretty-print the mobile device prestage as JSON for logging
+	resourceJSON, err := json.MarshalIndent(prestage, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Prestage Enrollment '%s' to JSON: %v", prestage.DisplayName, err)
+	}
+
+	log.Printf("[DEBUG] Constructed Jamf Pro Mobile Device Prestage Enrollment JSON:\n%s\n", string(resourceJSON))
+
+	return prestage, nil
+}
+
+// Helper functions for nested blocks
+
+func constructLocationInformation(data map[string]interface{}) mobileDevicePrestageLocationInformation {
+	return mobileDevicePrestageLocationInformation{
+		ID:           data["id"].(string),
+		Username:     data["username"].(string),
+		Realname:     data["realname"].(string),
+		Phone:        data["phone"].(string),
+		Email:        data["email"].(string),
+		Room:         data["room"].(string),
+		Position:     data["position"].(string),
+		DepartmentID: data["department_id"].(string),
+		BuildingID:   data["building_id"].(string),
+		VersionLock:  data["version_lock"].(int),
+	}
+}
+
+func constructPurchasingInformation(data map[string]interface{}) mobileDevicePrestagePurchasingInformation {
+	return mobileDevicePrestagePurchasingInformation{
+		ID:                data["id"].(string),
+		Leased:            data["leased"].(bool),
+		Purchased:         data["purchased"].(bool),
+		AppleCareID:       data["apple_care_id"].(string),
+		PONumber:          data["po_number"].(string),
+		Vendor:            data["vendor"].(string),
+		PurchasePrice:     data["purchase_price"].(string),
+		LifeExpectancy:    data["life_expectancy"].(int),
+		PurchasingAccount: data["purchasing_account"].(string),
+		PurchasingContact: data["purchasing_contact"].(string),
+		LeaseDate:         data["lease_date"].(string),
+		PODate:            data["po_date"].(string),
+		WarrantyDate:      data["warranty_date"].(string),
+		VersionLock:       data["version_lock"].(int),
+	}
+}
+
+func constructNames(data map[string]interface{}) mobileDevicePrestageNames {
+	names := mobileDevicePrestageNames{
+		AssignNamesUsing: data["assign_names_using"].(string),
+		DeviceNamePrefix: data["device_name_prefix"].(string),
+		DeviceNameSuffix: data["device_name_suffix"].(string),
+		SingleDeviceName: data["single_device_name"].(string),
+		ManageNames:      data["manage_names"].(bool),
+	}
+	names.DeviceNamingConfigured = names.AssignNamesUsing != ""
+
+	for _, name := range stringList(data["device_names"]) {
+		names.PrestageDeviceNames = append(names.PrestageDeviceNames, mobileDevicePrestageDeviceName{DeviceName: name})
+	}
+
+	return names
+}
+
+// stringList converts a Terraform list of strings into a string slice.
+func stringList(v interface{}) []string {
+	list, _ := v.([]interface{})
+	values := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,670 @@
+// mobiledeviceprestageenrollments_resource.go
+package mobiledeviceprestageenrollments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProMobileDevicePrestageEnrollment defines the schema for managing Jamf Pro Mobile Device Prestages in Terraform.
+func ResourceJamfProMobileDevicePrestageEnrollment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceJamfProMobileDevicePrestageEnrollmentCreate,
+		ReadContext:   ResourceJamfProMobileDevicePrestageEnrollmentRead,
+		UpdateContext: ResourceJamfProMobileDevicePrestageEnrollmentUpdate,
+		DeleteContext: ResourceJamfProMobileDevicePrestageEnrollmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := getMobileDevicePrestageByName(conn, name)
+			if err != nil {
+				return "", err
+			}
+			return resource.ID, nil
+		}),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the mobile device prestage.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the mobile device prestage.",
+			},
+			"mandatory": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates whether the mobile device prestage is mandatory.",
+			},
+			"mdm_removable": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if the MDM profile is removable.",
+			},
+			"support_phone_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Support phone number for the organization.",
+			},
+			"support_email_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Support email address for the organization.",
+			},
+			"department": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The department the mobile device prestage is assigned to.",
+			},
+			"default_prestage": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if this is the default mobile device prestage enrollment configuration. If yes then new devices will be automatically assigned to this PreStage enrollment",
+			},
+			"enrollment_site_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The jamf pro Site ID that mobile devices will be added to during enrollment. Default is -1, aka not used.",
+			},
+			"keep_existing_site_membership": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if existing device site membership should be retained.",
+			},
+			"keep_existing_location_information": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if existing device location information should be retained.",
+			},
+			"require_authentication": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if the user is required to provide username and password during enrollment.",
+			},
+			"authentication_prompt": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The authentication prompt message displayed to the user during enrollment.",
+			},
+			"prevent_activation_lock": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if activation lock should be prevented.",
+			},
+			"enable_device_based_activation_lock": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if device-based activation lock should be enabled.",
+			},
+			"device_enrollment_program_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The device enrollment program instance ID.",
+			},
+			"skip_setup_items": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Selected items are not displayed in the Setup Assistant during iOS, iPadOS and tvOS device setup within Apple Device Enrollment (ADE). Items left unset are displayed.",
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: skipSetupItemsSchema(),
+				},
+			},
+			"location_information": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Location information associated with the Jamf Pro mobile device prestage.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The username for the location information.",
+						},
+						"realname": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The real name associated with this location.",
+						},
+						"phone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The phone number associated with this location.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The email address associated with this location.",
+						},
+						"room": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The room associated with this location.",
+						},
+						"position": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The position associated with this location.",
+						},
+						"department_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The department ID associated with this location.",
+							Default:     "-1",
+						},
+						"building_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The building ID associated with this location.",
+							Default:     "-1",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the location information.",
+						},
+						"version_lock": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The optimistic locking version of the location information. Managed by the provider.",
+						},
+					},
+				},
+			},
+			"purchasing_information": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Purchasing information associated with the mobile device prestage.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the purchasing information.",
+						},
+						"leased": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Indicates if the item is leased.",
+						},
+						"purchased": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Indicates if the item is purchased.",
+						},
+						"apple_care_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The AppleCare ID.",
+						},
+						"po_number": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The purchase order number.",
+						},
+						"vendor": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The vendor name.",
+						},
+						"purchase_price": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The purchase price.",
+						},
+						"life_expectancy": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The life expectancy in years.",
+						},
+						"purchasing_account": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The purchasing account.",
+						},
+						"purchasing_contact": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The purchasing contact.",
+						},
+						"lease_date": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The lease date.",
+						},
+						"po_date": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The purchase order date.",
+						},
+						"warranty_date": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The warranty date.",
+						},
+						"version_lock": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The optimistic locking version of the purchasing information. Managed by the provider.",
+						},
+					},
+				},
+			},
+			"anchor_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of Base64 encoded PEM Certificates.",
+			},
+			"enrollment_customization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enrollment customization ID.",
+				Default:     "0",
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The language setting.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region setting.",
+			},
+			"auto_advance_setup": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Indicates if setup should auto-advance.",
+			},
+			"allow_pairing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the device can be paired with a computer.",
+			},
+			"multi_user": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the device is enrolled as a Shared iPad.",
+			},
+			"supervised": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the device is supervised.",
+			},
+			"maximum_shared_accounts": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The maximum number of users that can be cached on a Shared iPad. 0 lets the device decide.",
+			},
+			"configure_device_before_setup_assistant": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if configuration profiles are installed before the Setup Assistant is shown.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Device naming applied to mobile devices enrolled with this prestage.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_names_using": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The naming method. Must be one of 'Default Names', 'List of Names', 'Serial Numbers' or 'Single Name'.",
+							ValidateFunc: validation.StringInSlice([]string{"Default Names", "List of Names", "Serial Numbers", "Single Name"}, false),
+						},
+						"device_names": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names assigned to devices in order when assign_names_using is 'List of Names'.",
+						},
+						"device_name_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prefix added to device names when assign_names_using is 'Serial Numbers'.",
+						},
+						"device_name_suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The suffix added to device names when assign_names_using is 'Serial Numbers'.",
+						},
+						"single_device_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name assigned to every device when assign_names_using is 'Single Name'.",
+						},
+						"manage_names": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates if Jamf Pro restores the assigned name when it is changed on the device.",
+						},
+					},
+				},
+			},
+			"send_timezone": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the time zone is sent to the device.",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "UTC",
+				Description: "The time zone sent to the device when send_timezone is true.",
+			},
+			"storage_quota_size_megabytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     4096,
+				Description: "The storage quota of each user on a Shared iPad, in megabytes.",
+			},
+			"use_storage_quota_size": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if storage_quota_size_megabytes is applied instead of maximum_shared_accounts.",
+			},
+			"temporary_session_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if a Shared iPad only allows temporary sessions.",
+			},
+			"enforce_temporary_session_timeout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if temporary sessions on a Shared iPad time out.",
+			},
+			"temporary_session_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of minutes after which a temporary session times out.",
+			},
+			"enforce_user_session_timeout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if user sessions on a Shared iPad time out.",
+			},
+			"user_session_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of minutes after which a user session times out.",
+			},
+			"prestage_installed_profile_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of profiles installed during prestage.",
+			},
+			"profile_uuid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The profile UUID.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The site ID.",
+				Default:     "-1",
+			},
+			"version_lock": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The optimistic locking version of the mobile device prestage. Managed by the provider.",
+			},
+		},
+	}
+}
+
+// skipSetupItemsSchema returns a boolean attribute for every Setup Assistant pane in skipSetupItemKeys.
+func skipSetupItemsSchema() map[string]*schema.Schema {
+	items := make(map[string]*schema.Schema, len(skipSetupItemKeys))
+	for attribute := range skipSetupItemKeys {
+		items[attribute] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: fmt.Sprintf("Skip %s setup.", strings.ReplaceAll(attribute, "_", " ")),
+		}
+	}
+	return items
+}
+
+// ResourceJamfProMobileDevicePrestageEnrollmentCreate is responsible for creating a new mobile device prestage in Jamf Pro with terraform.
+// The function:
+// 1. Constructs the mobile device prestage data using the provided Terraform configuration.
+// 2. Calls the API to create the mobile device prestage in Jamf Pro.
+// 3. Updates the Terraform state with the ID of the newly created mobile device prestage.
+// 4. Initiates a read operation to synchronize the Terraform state with the actual state in Jamf Pro.
+func ResourceJamfProMobileDevicePrestageEnrollmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Assert the meta interface to the expected APIClient type
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	// Initialize variables
+	var diags diag.Diagnostics
+
+	// Construct the resource object
+	resource, err := constructJamfProMobileDevicePrestageEnrollment(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro mobile device prestage enrollment: %v", err))
+	}
+
+	// Retry the API call to create the resource in Jamf Pro
+	var resourceID string
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var apiErr error
+		resourceID, apiErr = createMobileDevicePrestage(conn, resource)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		// No error, exit the retry loop
+		return nil
+	})
+
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro mobile device prestage enrollment '%s'", resource.DisplayName))
+	}
+
+	// Set the resource ID in Terraform state
+	d.SetId(resourceID)
+
+	// Wait for the resource to be fully available before reading it
+	checkResourceExists := func(id interface{}) (interface{}, error) {
+		return getMobileDevicePrestageByID(conn, id.(string))
+	}
+
+	_, waitDiags := waitfor.ResourceIsAvailable(ctx, d, "Jamf Pro mobile device prestage enrollment", resourceID, checkResourceExists, 10*time.Second)
+	if waitDiags.HasError() {
+		return waitDiags
+	}
+
+	// Read the resource to ensure the Terraform state is up to date
+	readDiags := ResourceJamfProMobileDevicePrestageEnrollmentRead(ctx, d, meta)
+	if len(readDiags) > 0 {
+		return readDiags
+	}
+
+	return diags
+}
+
+// ResourceJamfProMobileDevicePrestageEnrollmentRead is responsible for reading the current state of a Jamf Pro mobile device prestage.
+// The function:
+// 1. Fetches the mobile device prestage's current state using its ID.
+// 2. Updates the Terraform state with the fetched data to ensure it accurately reflects the current state in Jamf Pro.
+// 3. Handles any discrepancies, such as the prestage being deleted outside of Terraform, to keep the Terraform state synchronized.
+func ResourceJamfProMobileDevicePrestageEnrollmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Initialize API client
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+
+	resourceID := d.Id()
+
+	// Attempt to fetch the resource by ID
+	resource, err := getMobileDevicePrestageByID(apiclient.Conn, resourceID)
+
+	if err != nil {
+		// Skip resource state removal if this is a create operation
+		if !d.IsNewResource() {
+			// If the error is a "not found" error, remove the resource from the state
+			if provider_diagnostics.IsNotFound(err) {
+				d.SetId("") // Remove the resource from Terraform state
+				return diag.Diagnostics{
+					{
+						Severity: diag.Warning,
+						Summary:  "Resource not found",
+						Detail:   fmt.Sprintf("Jamf Pro mobile device prestage enrollment resource with ID '%s' was not found and has been removed from the Terraform state.", resourceID),
+					},
+				}
+			}
+		}
+		// For other errors, or if this is a create operation, return a diagnostic error
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro mobile device prestage enrollment with ID '%s'", resourceID))
+	}
+
+	// Update the Terraform state with the fetched data
+	return updateTerraformState(d, resource)
+}
+
+// ResourceJamfProMobileDevicePrestageEnrollmentUpdate is responsible for updating an existing Jamf Pro mobile device prestage.
+func ResourceJamfProMobileDevicePrestageEnrollmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Initialize API client
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	// Initialize variables
+	var diags diag.Diagnostics
+	resourceID := d.Id()
+
+	// Construct the resource object
+	resource, err := constructJamfProMobileDevicePrestageEnrollment(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro mobile device prestage for update: %v", err))
+	}
+
+	// Jamf Pro rejects updates carrying stale version locks, so fetch the current ones first
+	current, err := getMobileDevicePrestageByID(conn, resourceID)
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Mobile Device Prestage '%s' (ID: %s) before update", resource.DisplayName, resourceID))
+	}
+	changed := remotelyChangedFields(d, current)
+	applyCurrentVersionLocks(resource, current)
+
+	// Update operations with retries
+	update := func() error {
+		return retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+			apiErr := updateMobileDevicePrestageByID(conn, resourceID, resource)
+			if apiErr != nil {
+				return provider_diagnostics.RetryError(apiErr)
+			}
+			// Successfully updated the resource, exit the retry loop
+			return nil
+		})
+	}
+	err = update()
+
+	// A lock conflict means the prestage was saved again since the locks were read, so read them once more and retry once
+	if provider_diagnostics.IsConflict(err) {
+		current, readErr := getMobileDevicePrestageByID(conn, resourceID)
+		if readErr == nil {
+			changed = remotelyChangedFields(d, current)
+			applyCurrentVersionLocks(resource, current)
+			err = update()
+		}
+	}
+
+	if err != nil {
+		if provider_diagnostics.IsConflict(err) {
+			return diag.Diagnostics{crud.RemoteChangesDiagnostic(diag.Error, "Jamf Pro mobile device prestage enrollment", resource.DisplayName, resourceID, changed)}
+		}
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Mobile Device Prestage '%s' (ID: %s)", resource.DisplayName, resourceID))
+	}
+
+	if len(changed) > 0 {
+		diags = append(diags, crud.RemoteChangesDiagnostic(diag.Warning, "Jamf Pro mobile device prestage enrollment", resource.DisplayName, resourceID, changed))
+	}
+
+	// Read the resource to ensure the Terraform state is up to date
+	readDiags := ResourceJamfProMobileDevicePrestageEnrollmentRead(ctx, d, meta)
+	if len(readDiags) > 0 {
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+// ResourceJamfProMobileDevicePrestageEnrollmentDelete is responsible for deleting a Jamf Pro Mobile Device Prestage.
+func ResourceJamfProMobileDevicePrestageEnrollmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Initialize API client
+	apiclient, ok := meta.(*client.APIClient)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.APIClient")
+	}
+	conn := apiclient.Conn
+
+	// Initialize variables
+	var diags diag.Diagnostics
+	resourceID := d.Id()
+
+	// Use the retry function for the delete operation with appropriate timeout
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		apiErr := conn.DeleteMobileDevicePrestageByID(resourceID)
+		if apiErr != nil {
+			return provider_diagnostics.RetryError(apiErr)
+		}
+		// Successfully deleted the resource, exit the retry loop
+		return nil
+	})
+
+	if err != nil {
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Mobile Device Prestage '%s' (ID: %s)", d.Get("display_name").(string), resourceID))
+	}
+
+	// Clear the ID from the Terraform state as the resource has been deleted
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,129 @@
+// mobiledeviceprestageenrollments_state.go
+package mobiledeviceprestageenrollments
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateTerraformState maps a Jamf Pro mobile device prestage response back onto the resource schema.
+func updateTerraformState(d *schema.ResourceData, resource *mobileDevicePrestage) diag.Diagnostics {
+	for key, val := range flattenMobileDevicePrestage(resource) {
+		if err := d.Set(key, val); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// flattenMobileDevicePrestage returns the attributes of a mobile device prestage keyed by schema name.
+func flattenMobileDevicePrestage(resource *mobileDevicePrestage) map[string]interface{} {
+	prestageAttributes := map[string]interface{}{
+		"display_name":                            resource.DisplayName,
+		"mandatory":                               resource.Mandatory,
+		"mdm_removable":                           resource.MDMRemovable,
+		"support_phone_number":                    resource.SupportPhoneNumber,
+		"support_email_address":                   resource.SupportEmailAddress,
+		"department":                              resource.Department,
+		"default_prestage":                        resource.DefaultPrestage,
+		"enrollment_site_id":                      resource.EnrollmentSiteID,
+		"keep_existing_site_membership":           resource.KeepExistingSiteMembership,
+		"keep_existing_location_information":      resource.KeepExistingLocationInformation,
+		"require_authentication":                  resource.RequireAuthentication,
+		"authentication_prompt":                   resource.AuthenticationPrompt,
+		"prevent_activation_lock":                 resource.PreventActivationLock,
+		"enable_device_based_activation_lock":     resource.EnableDeviceBasedActivationLock,
+		"device_enrollment_program_instance_id":   resource.DeviceEnrollmentProgramInstanceID,
+		"anchor_certificates":                     resource.AnchorCertificates,
+		"enrollment_customization_id":             resource.EnrollmentCustomizationID,
+		"language":                                resource.Language,
+		"region":                                  resource.Region,
+		"auto_advance_setup":                      resource.AutoAdvanceSetup,
+		"allow_pairing":                           resource.AllowPairing,
+		"multi_user":                              resource.MultiUser,
+		"supervised":                              resource.Supervised,
+		"maximum_shared_accounts":                 resource.MaximumSharedAccounts,
+		"configure_device_before_setup_assistant": resource.ConfigureDeviceBeforeSetupAssistant,
+		"send_timezone":                           resource.SendTimezone,
+		"timezone":                                resource.Timezone,
+		"storage_quota_size_megabytes":            resource.StorageQuotaSizeMegabytes,
+		"use_storage_quota_size":                  resource.UseStorageQuotaSize,
+		"temporary_session_only":                  resource.TemporarySessionOnly,
+		"enforce_temporary_session_timeout":       resource.EnforceTemporarySessionTimeout,
+		"temporary_session_timeout":               resource.TemporarySessionTimeout,
+		"enforce_user_session_timeout":            resource.EnforceUserSessionTimeout,
+		"user_session_timeout":                    resource.UserSessionTimeout,
+		"prestage_installed_profile_ids":          resource.PrestageInstalledProfileIDs,
+		"profile_uuid":                            resource.ProfileUUID,
+		"site_id":                                 resource.SiteID,
+		"version_lock":                            resource.VersionLock,
+	}
+
+	// Handle nested skip_setup_items, recording only the panes the provider manages
+	skipSetupItems := make(map[string]interface{}, len(skipSetupItemKeys))
+	for attribute, key := range skipSetupItemKeys {
+		skipSetupItems[attribute] = resource.SkipSetupItems[key]
+	}
+	prestageAttributes["skip_setup_items"] = []interface{}{skipSetupItems}
+
+	// Handle nested location_information
+	if locationInformation := resource.LocationInformation; locationInformation != (mobileDevicePrestageLocationInformation{}) {
+		prestageAttributes["location_information"] = []interface{}{
+			map[string]interface{}{
+				"id":            locationInformation.ID,
+				"username":      locationInformation.Username,
+				"realname":      locationInformation.Realname,
+				"phone":         locationInformation.Phone,
+				"email":         locationInformation.Email,
+				"room":          locationInformation.Room,
+				"position":      locationInformation.Position,
+				"department_id": locationInformation.DepartmentID,
+				"building_id":   locationInformation.BuildingID,
+				"version_lock":  locationInformation.VersionLock,
+			},
+		}
+	}
+
+	// Handle nested purchasing_information
+	if purchasingInformation := resource.PurchasingInformation; purchasingInformation != (mobileDevicePrestagePurchasingInformation{}) {
+		prestageAttributes["purchasing_information"] = []interface{}{
+			map[string]interface{}{
+				"id":                 purchasingInformation.ID,
+				"leased":             purchasingInformation.Leased,
+				"purchased":          purchasingInformation.Purchased,
+				"apple_care_id":      purchasingInformation.AppleCareID,
+				"po_number":          purchasingInformation.PONumber,
+				"vendor":             purchasingInformation.Vendor,
+				"purchase_price":     purchasingInformation.PurchasePrice,
+				"life_expectancy":    purchasingInformation.LifeExpectancy,
+				"purchasing_account": purchasingInformation.PurchasingAccount,
+				"purchasing_contact": purchasingInformation.PurchasingContact,
+				"lease_date":         purchasingInformation.LeaseDate,
+				"po_date":            purchasingInformation.PODate,
+				"warranty_date":      purchasingInformation.WarrantyDate,
+				"version_lock":       purchasingInformation.VersionLock,
+			},
+		}
+	}
+
+	// Handle nested names, which Jamf Pro returns even when device naming is not configured
+	if names := resource.Names; names.DeviceNamingConfigured || names.AssignNamesUsing != "" {
+		deviceNames := make([]interface{}, 0, len(names.PrestageDeviceNames))
+		for _, name := range names.PrestageDeviceNames {
+			deviceNames = append(deviceNames, name.DeviceName)
+		}
+		prestageAttributes["names"] = []interface{}{
+			map[string]interface{}{
+				"assign_names_using": names.AssignNamesUsing,
+				"device_names":       deviceNames,
+				"device_name_prefix": names.DeviceNamePrefix,
+				"device_name_suffix": names.DeviceNameSuffix,
+				"single_device_name": names.SingleDeviceName,
+				"manage_names":       names.ManageNames,
+			},
+		}
+	}
+
+	return prestageAttributes
+}
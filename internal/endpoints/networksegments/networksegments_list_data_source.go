@@ -0,0 +1,34 @@
+// networksegments_list_data_source.go
+package networksegments
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProNetworkSegmentsList lists the Jamf Pro network segments, optionally filtered by name.
+func DataSourceJamfProNetworkSegmentsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Network Segments",
+		Lister: listNetworkSegments,
+	}
+	return list.DataSource()
+}
+
+// listNetworkSegments returns the ID and name of every Jamf Pro network segment.
+func listNetworkSegments(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetNetworkSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, segment := range response.Results {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(segment.ID), Name: segment.Name})
+	}
+	return items, nil
+}
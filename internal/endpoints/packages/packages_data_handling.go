@@ -37,3 +37,115 @@ func customValidateFilePath(ctx context.Context, d *schema.ResourceDiff, meta in
 
 	return nil
 }
+
+// customDiffPackageFileHash hashes the file at package_file_path at plan time and compares it
+// with the hashes recorded in state when the file was last uploaded. A rebuilt package kept at the
+// same path therefore plans new md5_file_hash and file_hash values and an unknown package_uri, and
+// the update uploads the new build to JCDS.
+func customDiffPackageFileHash(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// New packages are uploaded regardless of their hash
+	if d.Id() == "" {
+		return nil
+	}
+
+	filePath, _ := d.Get("package_file_path").(string)
+	if filePath == "" || !d.NewValueKnown("package_file_path") {
+		return nil
+	}
+
+	hashType, _ := d.Get("hash_type").(string)
+	md5Hash, fileHash, err := generateFileHashes(filePath, hashType)
+	if err != nil {
+		return fmt.Errorf("failed to hash package file at plan time: %v", err)
+	}
+
+	stateMD5Hash, _ := d.Get("md5_file_hash").(string)
+	if stateMD5Hash != "" && md5Hash != stateMD5Hash {
+		if err := d.SetNew("md5_file_hash", md5Hash); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("package_uri"); err != nil {
+			return err
+		}
+		return d.SetNew("file_hash", fileHash)
+	}
+
+	// The file is unchanged; only record a hash of the newly selected type
+	stateFileHash, _ := d.Get("file_hash").(string)
+	if (stateFileHash != "" || d.HasChange("hash_type")) && fileHash != stateFileHash {
+		return d.SetNew("file_hash", fileHash)
+	}
+
+	return nil
+}
+
+// customDiffPackageMetadata reads the metadata of the flat package at package_file_path at plan
+// time into bundle_id, package_version, min_os_version and install_restart_action. When
+// use_package_metadata is true, info, os_requirements and reboot_required that are not set in
+// configuration are planned from the same metadata. Otherwise, as they are computed, they are
+// planned empty when they are not set so that removing them from configuration clears them.
+func customDiffPackageMetadata(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	filePath, _ := d.Get("package_file_path").(string)
+	pathKnown := filePath != "" && d.NewValueKnown("package_file_path")
+
+	metadata := &packageMetadata{}
+	if pathKnown {
+		var err error
+		metadata, err = readPackageMetadata(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read package metadata from %s: %v", filePath, err)
+		}
+
+		computed := map[string]string{
+			"bundle_id":              metadata.BundleID,
+			"package_version":        metadata.Version,
+			"min_os_version":         metadata.MinOSVersion,
+			"install_restart_action": metadata.RestartAction,
+		}
+		for key, value := range computed {
+			if d.Get(key).(string) != value {
+				if err := d.SetNew(key, value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	useMetadata := d.Get("use_package_metadata").(bool)
+	if useMetadata && !pathKnown {
+		// The values are derived once the package is known
+		return nil
+	}
+
+	planned := map[string]interface{}{
+		"info":            "",
+		"os_requirements": "",
+		"reboot_required": false,
+	}
+	if useMetadata {
+		if value := metadata.info(); value != "" {
+			planned["info"] = value
+		}
+		if value := metadata.osRequirements(); value != "" {
+			planned["os_requirements"] = value
+		}
+		if metadata.RestartAction != "" {
+			planned["reboot_required"] = metadata.rebootRequired()
+		}
+	}
+	for key, value := range planned {
+		if !rawConfig.GetAttr(key).IsNull() || d.Get(key) == value {
+			continue
+		}
+		if err := d.SetNew(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
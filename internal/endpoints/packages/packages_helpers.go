@@ -3,35 +3,105 @@ package packages
 
 import (
 	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"os"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	hashTypeMD5    = "MD5"
+	hashTypeSHA256 = "SHA_256"
+	hashTypeSHA512 = "SHA_512"
 )
 
 // generateMD5FileHash accepts a file path and returns an MD5 hash of the file's contents.
-// It opens the file, creates a new MD5 hash object, copies the file content into the hash object, and computes the MD5 checksum of the file.
 func generateMD5FileHash(filePath string) (string, error) {
+	md5Hash, _, err := generateFileHashes(filePath, hashTypeMD5)
+	return md5Hash, err
+}
+
+// generateFileHashes accepts a file path and a hash type and returns the MD5 hash of the file's
+// contents together with its hash of the requested type. The file is read only once, which
+// matters for multi gigabyte packages hashed at plan time.
+func generateFileHashes(filePath, hashType string) (string, string, error) {
+	var typed hash.Hash
+	switch hashType {
+	case hashTypeMD5, "":
+	case hashTypeSHA256:
+		typed = sha256.New()
+	case hashTypeSHA512:
+		typed = sha512.New()
+	default:
+		return "", "", fmt.Errorf("unsupported hash type %q", hashType)
+	}
+
 	// Open the file for reading
 	file, err := os.Open(filePath)
 	if err != nil {
-		return "", fmt.Errorf("failed to open file %s: %v", filePath, err)
+		return "", "", fmt.Errorf("failed to open file %s: %v", filePath, err)
 	}
 	defer file.Close()
 
-	// Create a new MD5 hash object
-	hash := md5.New()
+	// Copy the file content into each hash object
+	md5Hash := md5.New()
+	writer := io.Writer(md5Hash)
+	if typed != nil {
+		writer = io.MultiWriter(md5Hash, typed)
+	}
+	if _, err := io.Copy(writer, file); err != nil {
+		return "", "", fmt.Errorf("failed to hash file contents of %s: %v", filePath, err)
+	}
+
+	// Convert the checksums to hex strings
+	md5String := hex.EncodeToString(md5Hash.Sum(nil))
+	if typed == nil {
+		return md5String, md5String, nil
+	}
+	return md5String, hex.EncodeToString(typed.Sum(nil)), nil
+}
+
+// getJCDS2FileDetails looks up a package file in the Jamf Cloud Distribution Service by name and
+// returns its MD5 hash and download URI. Both are empty when the file is not stored in JCDS.
+func getJCDS2FileDetails(conn *jamfpro.Client, fileName string) (string, string, error) {
+	files, err := conn.GetJCDS2Packages()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to list JCDS 2.0 files: %v", err)
+	}
 
-	// Copy the file content into the hash object
-	if _, err := io.Copy(hash, file); err != nil {
-		return "", fmt.Errorf("failed to hash file contents of %s: %v", filePath, err)
+	for _, file := range files {
+		if file.FileName != fileName {
+			continue
+		}
+		fileURI, err := conn.GetJCDS2PackageURIByName(fileName)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get JCDS 2.0 URI for file '%s': %v", fileName, err)
+		}
+		return file.MD5, fileURI.URI, nil
 	}
 
-	// Compute the MD5 checksum of the file
-	hashBytes := hash.Sum(nil)
+	return "", "", nil
+}
 
-	// Convert the bytes to a hex string
-	hashString := hex.EncodeToString(hashBytes)
+// suppressImportedFilePathDiff suppresses the package_file_path diff of an imported package, whose
+// state has no file path, as long as the local file matches the hash of the file held in JCDS.
+func suppressImportedFilePathDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" || new == "" {
+		return false
+	}
+
+	stateHash, _ := d.Get("md5_file_hash").(string)
+	if stateHash == "" {
+		return false
+	}
 
-	return hashString, nil
+	fileHash, err := generateMD5FileHash(new)
+	return err == nil && fileHash == stateHash
 }
@@ -0,0 +1,34 @@
+// packages_list_data_source.go
+package packages
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProPackagesList lists the Jamf Pro packages, optionally filtered by name.
+func DataSourceJamfProPackagesList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Packages",
+		Lister: listPackages,
+	}
+	return list.DataSource()
+}
+
+// listPackages returns the ID and name of every Jamf Pro package.
+func listPackages(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetPackages()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Package))
+	for _, pkg := range response.Package {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(pkg.ID), Name: pkg.Name})
+	}
+	return items, nil
+}
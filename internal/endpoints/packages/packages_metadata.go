@@ -0,0 +1,232 @@
+// packages_metadata.go
+package packages
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/xar"
+)
+
+const (
+	// maxMetadataFileSize bounds the Distribution and PackageInfo files read from a package.
+	maxMetadataFileSize = 4 * 1024 * 1024
+
+	// latestMacOSMajorVersion is the newest major version of macOS included in os_requirements
+	// derived from a package's minimum OS version. Raise it when a new version of macOS is released.
+	latestMacOSMajorVersion = 26
+
+	restartActionNone     = "None"
+	restartActionLogout   = "RequireLogout"
+	restartActionRestart  = "RequireRestart"
+	restartActionShutdown = "RequireShutdown"
+)
+
+// packageMetadata holds the details of a flat installer package read from its Distribution and
+// PackageInfo files. Fields are empty when the package does not declare them.
+type packageMetadata struct {
+	BundleID      string
+	Version       string
+	MinOSVersion  string
+	RestartAction string
+}
+
+// distribution is the subset of a product archive's Distribution file read by the provider.
+type distribution struct {
+	Product *struct {
+		ID      string `xml:"id,attr"`
+		Version string `xml:"version,attr"`
+	} `xml:"product"`
+	AllowedOSVersions []osVersion `xml:"allowed-os-versions>os-version"`
+	VolumeCheckOS     []osVersion `xml:"volume-check>allowed-os-versions>os-version"`
+	PkgRefs           []struct {
+		ID           string `xml:"id,attr"`
+		Version      string `xml:"version,attr"`
+		OnConclusion string `xml:"onConclusion,attr"`
+	} `xml:"pkg-ref"`
+}
+
+type osVersion struct {
+	Min string `xml:"min,attr"`
+}
+
+// packageInfo is the subset of a component package's PackageInfo file read by the provider.
+type packageInfo struct {
+	Identifier        string `xml:"identifier,attr"`
+	Version           string `xml:"version,attr"`
+	PostinstallAction string `xml:"postinstall-action,attr"`
+}
+
+// restartActionSeverity orders restart actions from least to most disruptive.
+var restartActionSeverity = map[string]int{
+	"":                    0,
+	restartActionNone:     1,
+	restartActionLogout:   2,
+	restartActionRestart:  3,
+	restartActionShutdown: 4,
+}
+
+// readPackageMetadata reads the metadata of the flat package at filePath. Files that are not xar
+// archives, such as disk images, return empty metadata.
+func readPackageMetadata(filePath string) (*packageMetadata, error) {
+	archive, err := xar.Open(filePath)
+	if errors.Is(err, xar.ErrNotXar) {
+		return &packageMetadata{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	metadata := &packageMetadata{}
+
+	// A product archive describes itself in its Distribution file
+	if contents, err := archive.ReadFile("Distribution", maxMetadataFileSize); err == nil {
+		var dist distribution
+		if err := xml.Unmarshal(contents, &dist); err != nil {
+			return nil, fmt.Errorf("failed to parse Distribution: %v", err)
+		}
+		metadata.applyDistribution(&dist)
+	}
+
+	// Component packages, whether flat or inside a product archive, carry a PackageInfo file
+	for _, name := range archive.Files() {
+		if path.Base(name) != "PackageInfo" {
+			continue
+		}
+		contents, err := archive.ReadFile(name, maxMetadataFileSize)
+		if err != nil {
+			return nil, err
+		}
+		var info packageInfo
+		if err := xml.Unmarshal(contents, &info); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", name, err)
+		}
+		metadata.applyPackageInfo(&info)
+	}
+
+	return metadata, nil
+}
+
+// applyDistribution records the product identifier and version, the lowest allowed OS version and
+// the most disruptive restart action of the packages referenced by a Distribution file.
+func (m *packageMetadata) applyDistribution(dist *distribution) {
+	if dist.Product != nil {
+		m.BundleID, m.Version = dist.Product.ID, dist.Product.Version
+	}
+
+	for _, ref := range dist.PkgRefs {
+		// pkg-ref elements that only reference a package from a choice carry no version
+		if ref.Version == "" {
+			continue
+		}
+		if m.BundleID == "" {
+			m.BundleID, m.Version = ref.ID, ref.Version
+		}
+		m.raiseRestartAction(ref.OnConclusion)
+	}
+
+	for _, version := range append(dist.AllowedOSVersions, dist.VolumeCheckOS...) {
+		if version.Min != "" && (m.MinOSVersion == "" || compareVersions(version.Min, m.MinOSVersion) < 0) {
+			m.MinOSVersion = version.Min
+		}
+	}
+}
+
+// applyPackageInfo records the identifier and version of a component package when no Distribution
+// file named them, and its restart action.
+func (m *packageMetadata) applyPackageInfo(info *packageInfo) {
+	if m.BundleID == "" {
+		m.BundleID, m.Version = info.Identifier, info.Version
+	}
+
+	switch strings.ToLower(info.PostinstallAction) {
+	case "none":
+		m.raiseRestartAction(restartActionNone)
+	case "logout":
+		m.raiseRestartAction(restartActionLogout)
+	case "restart":
+		m.raiseRestartAction(restartActionRestart)
+	case "shutdown":
+		m.raiseRestartAction(restartActionShutdown)
+	}
+}
+
+// raiseRestartAction keeps the more disruptive of the recorded action and action.
+func (m *packageMetadata) raiseRestartAction(action string) {
+	severity, ok := restartActionSeverity[action]
+	if ok && severity > restartActionSeverity[m.RestartAction] {
+		m.RestartAction = action
+	}
+}
+
+// rebootRequired reports whether installing the package requires a restart or shut down.
+func (m *packageMetadata) rebootRequired() bool {
+	return m.RestartAction == restartActionRestart || m.RestartAction == restartActionShutdown
+}
+
+// info returns the package's identifier and version for the Jamf Pro info field.
+func (m *packageMetadata) info() string {
+	return strings.TrimSpace(m.BundleID + " " + m.Version)
+}
+
+// osRequirements converts the package's minimum OS version into the comma separated list of
+// versions Jamf Pro expects, e.g. "10.15.x, 11.x, 12.x". Versions from macOS 11 onwards are
+// matched by major version only.
+func (m *packageMetadata) osRequirements() string {
+	if m.MinOSVersion == "" {
+		return ""
+	}
+
+	parts := strings.Split(m.MinOSVersion, ".")
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ""
+	}
+
+	var versions []string
+	if major == 10 {
+		minor := 0
+		if len(parts) > 1 {
+			minor, _ = strconv.Atoi(parts[1])
+		}
+		for ; minor <= 15; minor++ {
+			versions = append(versions, fmt.Sprintf("10.%d.x", minor))
+		}
+		major = 11
+	}
+	for last := max(latestMacOSMajorVersion, major); major <= last; major++ {
+		versions = append(versions, fmt.Sprintf("%d.x", major))
+		if major == 15 {
+			// macOS 15 was followed by macOS 26
+			major = 25
+		}
+	}
+
+	return strings.Join(versions, ", ")
+}
+
+// compareVersions compares two dotted version strings numerically, returning -1, 0 or 1.
+func compareVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(aParts), len(bParts)); i++ {
+		var x, y int
+		if i < len(aParts) {
+			x, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			y, _ = strconv.Atoi(bParts[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,267 @@
+// packages_metadata_test.go
+package packages
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/xar/xartest"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestReadPackageMetadata(t *testing.T) {
+	distribution := `<?xml version="1.0" encoding="utf-8"?>
+<installer-gui-script minSpecVersion="2">
+	<product id="com.example.app" version="1.2.3"/>
+	<allowed-os-versions>
+		<os-version min="11.0"/>
+		<os-version min="10.15"/>
+	</allowed-os-versions>
+	<choice id="default"><pkg-ref id="com.example.app.pkg"/></choice>
+	<pkg-ref id="com.example.app.pkg" version="1.2.3" onConclusion="RequireRestart">#app.pkg</pkg-ref>
+	<pkg-ref id="com.example.helper.pkg" version="1.0" onConclusion="None">#helper.pkg</pkg-ref>
+</installer-gui-script>`
+
+	tests := []struct {
+		name       string
+		files      []xartest.File
+		want       packageMetadata
+		wantInfo   string
+		wantOS     string
+		wantReboot bool
+	}{
+		{
+			name: "product archive",
+			files: []xartest.File{
+				{Path: "Distribution", Contents: distribution},
+				{Path: "app.pkg/PackageInfo", Contents: `<pkg-info identifier="com.example.app.pkg" version="1.2.3" postinstall-action="logout"/>`, Compressed: true},
+			},
+			want:       packageMetadata{BundleID: "com.example.app", Version: "1.2.3", MinOSVersion: "10.15", RestartAction: restartActionRestart},
+			wantInfo:   "com.example.app 1.2.3",
+			wantOS:     "10.15.x, 11.x, 12.x, 13.x, 14.x, 15.x, 26.x",
+			wantReboot: true,
+		},
+		{
+			name: "component package",
+			files: []xartest.File{
+				{Path: "PackageInfo", Contents: `<pkg-info identifier="com.example.tool" version="2.0" postinstall-action="none"/>`},
+			},
+			want:     packageMetadata{BundleID: "com.example.tool", Version: "2.0", RestartAction: restartActionNone},
+			wantInfo: "com.example.tool 2.0",
+		},
+		{
+			name: "distribution without a product",
+			files: []xartest.File{
+				{Path: "Distribution", Contents: `<installer-gui-script><pkg-ref id="com.example.tool" version="3.1" onConclusion="RequireLogout"/></installer-gui-script>`},
+			},
+			want:     packageMetadata{BundleID: "com.example.tool", Version: "3.1", RestartAction: restartActionLogout},
+			wantInfo: "com.example.tool 3.1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "test.pkg")
+			if err := xartest.Write(filePath, tc.files); err != nil {
+				t.Fatal(err)
+			}
+
+			metadata, err := readPackageMetadata(filePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *metadata != tc.want {
+				t.Fatalf("metadata is %+v, want %+v", *metadata, tc.want)
+			}
+			if info := metadata.info(); info != tc.wantInfo {
+				t.Errorf("info is %q, want %q", info, tc.wantInfo)
+			}
+			if osRequirements := metadata.osRequirements(); osRequirements != tc.wantOS {
+				t.Errorf("os_requirements are %q, want %q", osRequirements, tc.wantOS)
+			}
+			if rebootRequired := metadata.rebootRequired(); rebootRequired != tc.wantReboot {
+				t.Errorf("reboot_required is %t, want %t", rebootRequired, tc.wantReboot)
+			}
+		})
+	}
+}
+
+func TestReadPackageMetadataNotFlatPackage(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "test.dmg")
+	if err := os.WriteFile(filePath, []byte("not a package"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := readPackageMetadata(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *metadata != (packageMetadata{}) {
+		t.Fatalf("metadata of a disk image is %+v, want it empty", *metadata)
+	}
+}
+
+func TestOSRequirements(t *testing.T) {
+	tests := []struct {
+		minOSVersion string
+		want         string
+	}{
+		{"", ""},
+		{"not a version", ""},
+		{"10.13", "10.13.x, 10.14.x, 10.15.x, 11.x, 12.x, 13.x, 14.x, 15.x, 26.x"},
+		{"10.15.7", "10.15.x, 11.x, 12.x, 13.x, 14.x, 15.x, 26.x"},
+		{"10", "10.0.x, 10.1.x, 10.2.x, 10.3.x, 10.4.x, 10.5.x, 10.6.x, 10.7.x, 10.8.x, 10.9.x, 10.10.x, 10.11.x, 10.12.x, 10.13.x, 10.14.x, 10.15.x, 11.x, 12.x, 13.x, 14.x, 15.x, 26.x"},
+		{"11.0", "11.x, 12.x, 13.x, 14.x, 15.x, 26.x"},
+		{"14.2.1", "14.x, 15.x, 26.x"},
+		{"15", "15.x, 26.x"},
+		{"26.0", "26.x"},
+		{"27.1", "27.x"},
+	}
+
+	for _, tc := range tests {
+		metadata := packageMetadata{MinOSVersion: tc.minOSVersion}
+		if got := metadata.osRequirements(); got != tc.want {
+			t.Errorf("os_requirements for %q are %q, want %q", tc.minOSVersion, got, tc.want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"10.15", "10.15", 0},
+		{"10.15", "10.15.0", 0},
+		{"10.9", "10.15", -1},
+		{"10.15", "10.9", 1},
+		{"11.0", "10.15.7", 1},
+		{"10.15.7", "11", -1},
+		{"12.0.1", "12.0", 1},
+		{"", "10", -1},
+	}
+
+	for _, tc := range tests {
+		if got := compareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+// planPackage plans config, a map of attribute values, against a package in state with prior and
+// returns the planned attribute values.
+func planPackage(t *testing.T, prior map[string]string, config map[string]interface{}) map[string]string {
+	t.Helper()
+
+	r := ResourceJamfProPackages()
+	block := r.CoreConfigSchema()
+	values := map[string]cty.Value{}
+	for name, attribute := range block.Attributes {
+		values[name] = cty.NullVal(attribute.Type)
+		switch v := config[name].(type) {
+		case string:
+			values[name] = cty.StringVal(v)
+		case bool:
+			values[name] = cty.BoolVal(v)
+		}
+	}
+	for name, nested := range block.BlockTypes {
+		values[name] = cty.NullVal(nested.Block.ImpliedType())
+	}
+	raw := cty.ObjectVal(values)
+
+	state := &terraform.InstanceState{ID: "1", Attributes: prior, RawConfig: raw}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(raw, block), &client.APIClient{})
+	if err != nil {
+		t.Fatalf("failed to plan the package: %v", err)
+	}
+
+	planned := map[string]string{}
+	for key, value := range prior {
+		planned[key] = value
+	}
+	if diff != nil {
+		for key, change := range diff.Attributes {
+			planned[key] = change.New
+		}
+	}
+	return planned
+}
+
+func TestCustomizeDiffPackageMetadata(t *testing.T) {
+	productPath := filepath.Join(t.TempDir(), "product.pkg")
+	if err := xartest.Write(productPath, []xartest.File{{
+		Path:     "Distribution",
+		Contents: `<installer-gui-script><product id="com.example.app" version="1.2.3"/><allowed-os-versions><os-version min="14.0"/></allowed-os-versions><pkg-ref id="com.example.app" version="1.2.3" onConclusion="RequireRestart"/></installer-gui-script>`,
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	diskImagePath := filepath.Join(t.TempDir(), "tool.dmg")
+	if err := os.WriteFile(diskImagePath, []byte("not a package"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	prior := func(filePath string) map[string]string {
+		return map[string]string{
+			"id":                "1",
+			"name":              "App",
+			"package_file_path": filePath,
+			"category":          "Unknown",
+			"info":              "Old info",
+			"os_requirements":   "12.x",
+			"reboot_required":   "true",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		config   map[string]interface{}
+		want     map[string]string
+	}{
+		{
+			name:     "removed from configuration",
+			filePath: diskImagePath,
+			want:     map[string]string{"info": "", "os_requirements": "", "reboot_required": "false"},
+		},
+		{
+			name:     "set in configuration",
+			filePath: productPath,
+			config:   map[string]interface{}{"info": "Old info", "os_requirements": "12.x", "reboot_required": true, "use_package_metadata": true},
+			want:     map[string]string{"info": "Old info", "os_requirements": "12.x", "reboot_required": "true"},
+		},
+		{
+			name:     "derived from the package",
+			filePath: productPath,
+			config:   map[string]interface{}{"use_package_metadata": true},
+			want:     map[string]string{"info": "com.example.app 1.2.3", "os_requirements": "14.x, 15.x, 26.x", "reboot_required": "true"},
+		},
+		{
+			name:     "not derived from a disk image",
+			filePath: diskImagePath,
+			config:   map[string]interface{}{"use_package_metadata": true},
+			want:     map[string]string{"info": "", "os_requirements": "", "reboot_required": "false"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{"name": "App", "package_file_path": tc.filePath, "category": "Unknown"}
+			for key, value := range tc.config {
+				config[key] = value
+			}
+
+			planned := planPackage(t, prior(tc.filePath), config)
+			for key, want := range tc.want {
+				if planned[key] != want {
+					t.Errorf("%s is planned as %q, want %q", key, planned[key], want)
+				}
+			}
+		})
+	}
+}
@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"path/filepath"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceJamfProPackages defines the schema and CRUD operations for managing Jamf Pro Packages in Terraform.
@@ -31,7 +35,19 @@ func ResourceJamfProPackages() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
-		CustomizeDiff: customValidateFilePath,
+		CustomizeDiff: customdiff.All(
+			customValidateFilePath,
+			customDiffPackageFileHash,
+			customDiffPackageMetadata,
+			defaults.CustomizeDiff(defaults.Field{Key: "category", Kind: defaults.Category, Format: defaults.Name, Required: true}),
+		),
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetPackageByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -53,15 +69,43 @@ func ResourceJamfProPackages() *schema.Resource {
 				Computed:    true,
 				Description: "md5 hash of the package file for integrity comparison.",
 			},
-			"package_file_path": {
+			"hash_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      hashTypeMD5,
+				Description:  "The hash algorithm recorded in file_hash and compared at plan time to detect a changed package file. One of 'MD5', 'SHA_256' or 'SHA_512'.",
+				ValidateFunc: validation.StringInSlice([]string{hashTypeMD5, hashTypeSHA256, hashTypeSHA512}, false),
+			},
+			"file_hash": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The file path of the Jamf Pro package.",
+				Computed:    true,
+				Description: "The hash of the uploaded package file, using the algorithm selected by hash_type.",
+			},
+			"upload_part_size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultUploadPartSizeMB,
+				Description:  "The size in MiB of each part of the multipart upload of the package file to JCDS 2.0. Must be at least 5. It is raised automatically when the file would otherwise need more than 10,000 parts.",
+				ValidateFunc: validation.IntAtLeast(minUploadPartSizeMB),
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultUploadConcurrency,
+				Description:  "The number of parts of the package file uploaded to JCDS 2.0 in parallel.",
+				ValidateFunc: validation.IntBetween(1, maxUploadConcurrency),
+			},
+			"package_file_path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The file path of the Jamf Pro package.",
+				DiffSuppressFunc: suppressImportedFilePathDiff,
 			},
 			"category": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The category of the Jamf Pro package.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The category of the Jamf Pro package. Required unless the provider sets 'default_category', which applies when this is not set.",
 				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
 					v, ok := val.(string)
 					if !ok {
@@ -85,7 +129,8 @@ func ResourceJamfProPackages() *schema.Resource {
 			"info": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Information about the Jamf Pro package.",
+				Computed:    true,
+				Description: "Information about the Jamf Pro package. When not set, it is derived from the package metadata if use_package_metadata is true and is empty otherwise.",
 			},
 			"notes": {
 				Type:        schema.TypeString,
@@ -100,7 +145,8 @@ func ResourceJamfProPackages() *schema.Resource {
 			"reboot_required": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Whether a reboot is required after installing the Jamf Pro package.",
+				Computed:    true,
+				Description: "Whether a reboot is required after installing the Jamf Pro package. When not set, it is derived from the package metadata if use_package_metadata is true and is false otherwise.",
 			},
 			"fill_user_template": {
 				Type:        schema.TypeBool,
@@ -126,7 +172,8 @@ func ResourceJamfProPackages() *schema.Resource {
 			"os_requirements": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The OS requirements for the Jamf Pro package.",
+				Computed:    true,
+				Description: "The OS requirements for the Jamf Pro package. When not set, they are derived from the package metadata if use_package_metadata is true and are empty otherwise.",
 			},
 			/* Fields are in the data model but don't appear to serve a purpose in jamf 11.3 onwards
 			"required_processor": {
@@ -160,6 +207,32 @@ func ResourceJamfProPackages() *schema.Resource {
 				Description: "The triggering files for the Jamf Pro package.",
 			},
 			*/
+			"use_package_metadata": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether info, os_requirements and reboot_required default to values derived from the metadata of a flat .pkg file when they are not set in configuration.",
+			},
+			"bundle_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The product or package identifier read from the Distribution or PackageInfo file of a flat .pkg file.",
+			},
+			"package_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The product or package version read from the Distribution or PackageInfo file of a flat .pkg file.",
+			},
+			"min_os_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lowest macOS version the Distribution file of a flat .pkg file allows the package to be installed on.",
+			},
+			"install_restart_action": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The most disruptive action a flat .pkg file requests once installed. One of 'None', 'RequireLogout', 'RequireRestart' or 'RequireShutdown'.",
+			},
 			"send_notification": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -189,21 +262,16 @@ func ResourceJamfProPackagesCreate(ctx context.Context, d *schema.ResourceData,
 	// Extract the file path for the package
 	filePath := d.Get("package_file_path").(string)
 
-	// Step 1: Call CreateJCDS2PackageV2 to upload the file to JCDS 2.0
-	fileUploadResponse, err := conn.CreateJCDS2PackageV2(filePath)
+	// Step 1: Generate the file hashes used to verify the upload and detect later changes
+	md5FileHash, fileHash, err := generateFileHashes(filePath, d.Get("hash_type").(string))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to upload file to JCDS 2.0 with file path '%s': %v", filePath, err))
+		return diag.FromErr(fmt.Errorf("failed to generate file hash for %s: %v", filePath, err))
 	}
-	fmt.Printf("File uploaded successfully, URI: %s\n", fileUploadResponse.URI)
-
-	packageURI := fileUploadResponse.URI
 
-	// After file upload generate the file hash
-	fullPath := d.Get("package_file_path").(string)
-	fileHash, err := generateMD5FileHash(fullPath)
+	// Step 2: Upload the file to JCDS 2.0 and verify it against the hash reported by Jamf Pro
+	packageURI, err := uploadPackageToJCDS(ctx, conn, apiclient.UploadHTTPClient, filePath, md5FileHash, d.Get("upload_part_size_mb").(int), d.Get("upload_concurrency").(int), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		// Handle error, return diagnostic message
-		return diag.FromErr(fmt.Errorf("failed to generate file hash for %s: %v", fullPath, err))
+		return diag.FromErr(err)
 	}
 
 	// Construct the resource object
@@ -215,10 +283,10 @@ func ResourceJamfProPackagesCreate(ctx context.Context, d *schema.ResourceData,
 	// Dereference the pointer to get the value
 	packageResource := *packageResourcePointer
 
-	// Step 2: Call CreatePackage to create the package metadata in Jamf Pro
+	// Step 3: Call CreatePackage to create the package metadata in Jamf Pro
 	creationResponse, err := conn.CreatePackage(packageResource)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Package '%s': %v", packageResource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Package '%s'", packageResource.Name))
 	}
 
 	// Set the resource ID, package URI and file hash in Terraform state
@@ -228,7 +296,11 @@ func ResourceJamfProPackagesCreate(ctx context.Context, d *schema.ResourceData,
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	if err := d.Set("md5_file_hash", fileHash); err != nil {
+	if err := d.Set("md5_file_hash", md5FileHash); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("file_hash", fileHash); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -285,19 +357,14 @@ func ResourceJamfProPackagesRead(ctx context.Context, d *schema.ResourceData, me
 		var apiErr error
 		resource, apiErr = conn.GetPackageByID(resourceIDInt)
 		if apiErr != nil {
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
-				// Return non-retryable error with a message to avoid SDK issues
-				return retry.NonRetryableError(fmt.Errorf("resource not found, marked for deletion"))
-			}
-			// Retry for other types of errors
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	// If err is not nil, check if it's due to the resource being not found
 	if err != nil {
-		if err.Error() == "resource not found, marked for deletion" {
+		if provider_diagnostics.IsNotFound(err) {
 			// Resource not found, remove from Terraform state
 			d.SetId("")
 			// Append a warning diagnostic and return
@@ -310,7 +377,7 @@ func ResourceJamfProPackagesRead(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Package with ID '%s' after retries: %v", resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Package with ID '%s'", resourceID))
 	}
 
 	// Update Terraform state with the resource information
@@ -388,6 +455,42 @@ func ResourceJamfProPackagesRead(ctx context.Context, d *schema.ResourceData, me
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	// hash_type and the upload settings are local to the provider, so default them when importing
+	if d.Get("hash_type").(string) == "" {
+		if err := d.Set("hash_type", hashTypeMD5); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	if d.Get("upload_part_size_mb").(int) == 0 {
+		if err := d.Set("upload_part_size_mb", defaultUploadPartSizeMB); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	if d.Get("upload_concurrency").(int) == 0 {
+		if err := d.Set("upload_concurrency", defaultUploadConcurrency); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	// An imported package has no local file, so take the hash and URI from JCDS instead
+	if d.Get("md5_file_hash").(string) == "" && resource.Filename != "" {
+		fileHash, packageURI, err := getJCDS2FileDetails(conn, resource.Filename)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to read package file details from JCDS",
+				Detail:   err.Error(),
+			})
+		} else if fileHash != "" {
+			if err := d.Set("md5_file_hash", fileHash); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+			if err := d.Set("package_uri", packageURI); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+
 	return diags
 }
 
@@ -407,26 +510,29 @@ func ResourceJamfProPackagesUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error converting package ID '%s' to integer: %v", d.Id(), err))
 	}
 
-	// Check if package_file_path has changed
-	if d.HasChange("package_file_path") {
-		// Step 1: Calculate the new file hash
+	// Check if package_file_path has changed, or the file at the same path was rebuilt
+	if d.HasChanges("package_file_path", "md5_file_hash", "file_hash") {
+		// Step 1: Calculate the new file hashes
 		filePath := d.Get("package_file_path").(string)
-		newFileHash, err := generateMD5FileHash(filePath)
+		newFileHash, newTypedFileHash, err := generateFileHashes(filePath, d.Get("hash_type").(string))
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to generate file hash for %s: %v", filePath, err))
 		}
+		if err := d.Set("file_hash", newTypedFileHash); err != nil {
+			return diag.FromErr(err)
+		}
 
 		// Step 2: Compare the new file hash with the old one
 		oldFileHash, _ := d.GetChange("md5_file_hash")
 		if newFileHash != oldFileHash.(string) {
 			// The file has changed, upload it
-			fileUploadResponse, err := conn.CreateJCDS2PackageV2(filePath)
+			packageURI, err := uploadPackageToJCDS(ctx, conn, apiclient.UploadHTTPClient, filePath, newFileHash, d.Get("upload_part_size_mb").(int), d.Get("upload_concurrency").(int), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("failed to upload file to JCDS 2.0 with file path '%s': %v", filePath, err))
+				return diag.FromErr(err)
 			}
 
 			// Update the package_uri and md5_file_hash in Terraform state
-			d.Set("package_uri", fileUploadResponse.URI)
+			d.Set("package_uri", packageURI)
 			d.Set("md5_file_hash", newFileHash)
 			d.Set("filename", filepath.Base(filePath))
 		}
@@ -441,7 +547,7 @@ func ResourceJamfProPackagesUpdate(ctx context.Context, d *schema.ResourceData,
 	// Update package metadata in Jamf Pro using the integer package ID
 	_, err = conn.UpdatePackageByID(packageID, packageResource)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update package with ID %d: %v", packageID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update package with ID %d", packageID))
 	}
 
 	// Read the updated state
@@ -481,8 +587,8 @@ func ResourceJamfProPackagesDelete(ctx context.Context, d *schema.ResourceData,
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeletePackageByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -490,7 +596,7 @@ func ResourceJamfProPackagesDelete(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Package '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Package '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
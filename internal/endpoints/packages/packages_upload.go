@@ -0,0 +1,483 @@
+// packages_upload.go
+package packages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	uriJCDS2Files = "/api/v1/jcds/files"
+
+	mebibyte = 1024 * 1024
+
+	defaultUploadPartSizeMB  = 64
+	minUploadPartSizeMB      = 5 // The smallest part S3 accepts, other than the last one
+	defaultUploadConcurrency = 4
+	maxUploadConcurrency     = 32
+	maxUploadParts           = 10000 // The most parts S3 accepts in a single multipart upload
+
+	// uploadVerifyTimeout bounds the wait for Jamf Pro to list an uploaded file with its hash.
+	uploadVerifyTimeout = 2 * time.Minute
+)
+
+var errIncompleteCredentials = errors.New("incomplete JCDS 2.0 upload credentials received")
+
+// jcdsUpload is a multipart upload of a package file to JCDS 2.0. It records the parts that have
+// been stored so that an upload interrupted by a transient error resumes where it stopped instead
+// of starting again.
+type jcdsUpload struct {
+	conn        *jamfpro.Client
+	httpClient  *http.Client
+	file        *os.File
+	fileName    string
+	size        int64
+	partSize    int64
+	partCount   int
+	concurrency int
+
+	credentials jamfpro.ResponseJCDS2UploadCredentials
+	client      *s3.Client
+	key         string
+	uploadID    string
+
+	mu        sync.Mutex
+	completed map[int32]types.CompletedPart
+	uploaded  int64
+}
+
+// uploadPackageToJCDS uploads the file at filePath to JCDS 2.0 in parts of partSizeMB, sending up to
+// concurrency parts at a time through httpClient, or the default AWS HTTP client when it is nil. Transient failures are retried within timeout, resuming from the
+// parts already stored. Once the upload completes, the MD5 hash Jamf Pro reports for the file is
+// compared with md5Hash, the hash of the local file. The function returns the URI of the file.
+func uploadPackageToJCDS(ctx context.Context, conn *jamfpro.Client, httpClient *http.Client, filePath, md5Hash string, partSizeMB, concurrency int, timeout time.Duration) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file %s: %v", filePath, err)
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to get file info for %s: %v", filePath, err)
+	}
+
+	upload := &jcdsUpload{
+		conn:        conn,
+		httpClient:  httpClient,
+		file:        file,
+		fileName:    filepath.Base(filePath),
+		size:        fileInfo.Size(),
+		partSize:    uploadPartSize(fileInfo.Size(), int64(partSizeMB)*mebibyte),
+		concurrency: max(1, min(concurrency, maxUploadConcurrency)),
+		completed:   make(map[int32]types.CompletedPart),
+	}
+	upload.partCount = int(max(1, (upload.size+upload.partSize-1)/upload.partSize))
+
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		err := upload.run(ctx)
+		if err == nil {
+			return nil
+		}
+		if isPermanentUploadError(err) {
+			return retry.NonRetryableError(err)
+		}
+
+		logging.LogJCDSUploadRetry(ctx, upload.fileName, upload.completedParts(), upload.partCount, err.Error())
+
+		if isExpiredCredentialsError(err) {
+			if renewErr := upload.renewCredentials(ctx); renewErr != nil {
+				return retry.RetryableError(renewErr)
+			}
+		}
+		if isNoSuchUploadError(err) {
+			upload.restart()
+		} else if syncErr := upload.syncCompletedParts(ctx); syncErr != nil {
+			logging.Warn(ctx, logging.SubsystemUpload, "Failed to list the parts already stored in JCDS 2.0, resuming from the parts recorded locally", map[string]interface{}{
+				"file_name": upload.fileName,
+				"error":     syncErr.Error(),
+			})
+		}
+
+		return retry.RetryableError(err)
+	})
+	if err != nil {
+		upload.abort()
+		return "", fmt.Errorf("failed to upload file to JCDS 2.0 with file path '%s': %v", filePath, err)
+	}
+
+	if err := verifyJCDSUpload(ctx, conn, upload.fileName, md5Hash); err != nil {
+		return "", err
+	}
+
+	fileURI := fmt.Sprintf("s3://%s/%s", upload.credentials.BucketName, upload.key)
+	logging.LogJCDSUploadSuccess(ctx, upload.fileName, fileURI, md5Hash, upload.size)
+
+	return fileURI, nil
+}
+
+// uploadPartSize returns the part size to upload a file of fileSize bytes with. The requested size
+// is raised to the S3 minimum, and further when the file would otherwise need more parts than S3
+// allows.
+func uploadPartSize(fileSize, requested int64) int64 {
+	partSize := max(requested, minUploadPartSizeMB*mebibyte)
+	if fileSize > partSize*maxUploadParts {
+		partSize = (fileSize + maxUploadParts - 1) / maxUploadParts
+	}
+	return partSize
+}
+
+// run performs the steps of the upload that have not completed yet: obtaining credentials,
+// starting the multipart upload, uploading the outstanding parts and completing the upload.
+func (u *jcdsUpload) run(ctx context.Context) error {
+	if u.client == nil {
+		if err := u.requestCredentials(ctx); err != nil {
+			return err
+		}
+	}
+
+	if u.uploadID == "" {
+		out, err := u.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(u.credentials.BucketName),
+			Key:    aws.String(u.key),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to start multipart upload of %s: %w", u.fileName, err)
+		}
+		u.uploadID = aws.ToString(out.UploadId)
+	}
+
+	if err := u.uploadParts(ctx); err != nil {
+		return err
+	}
+
+	_, err := u.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.credentials.BucketName),
+		Key:             aws.String(u.key),
+		UploadId:        aws.String(u.uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: u.completedPartList()},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload of %s: %w", u.fileName, err)
+	}
+
+	return nil
+}
+
+// uploadParts uploads every part that has not been stored yet using up to u.concurrency workers.
+// The first failure stops the remaining workers and is returned.
+func (u *jcdsUpload) uploadParts(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	pending := make(chan int32)
+	for i := 0; i < u.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for partNumber := range pending {
+				if err := u.uploadPart(ctx, partNumber); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+queue:
+	for _, partNumber := range u.outstandingParts() {
+		select {
+		case pending <- partNumber:
+		case <-ctx.Done():
+			break queue
+		}
+	}
+	close(pending)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// uploadPart uploads a single part of the file and records it as completed.
+func (u *jcdsUpload) uploadPart(ctx context.Context, partNumber int32) error {
+	offset, length := u.partRange(partNumber)
+
+	out, err := u.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        aws.String(u.credentials.BucketName),
+		Key:           aws.String(u.key),
+		UploadId:      aws.String(u.uploadID),
+		PartNumber:    aws.Int32(partNumber),
+		ContentLength: aws.Int64(length),
+		Body:          io.NewSectionReader(u.file, offset, length),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload part %d of %s: %w", partNumber, u.fileName, err)
+	}
+
+	u.mu.Lock()
+	u.completed[partNumber] = types.CompletedPart{ETag: out.ETag, PartNumber: aws.Int32(partNumber)}
+	u.uploaded += length
+	completedParts, uploaded := len(u.completed), u.uploaded
+	u.mu.Unlock()
+
+	logging.LogJCDSUploadProgress(ctx, u.fileName, int(partNumber), completedParts, u.partCount, uploaded, u.size)
+
+	return nil
+}
+
+// partRange returns the offset and length of a part within the file.
+func (u *jcdsUpload) partRange(partNumber int32) (int64, int64) {
+	offset := int64(partNumber-1) * u.partSize
+	return offset, min(u.partSize, u.size-offset)
+}
+
+// outstandingParts returns the numbers of the parts that have not been stored yet.
+func (u *jcdsUpload) outstandingParts() []int32 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	var parts []int32
+	for partNumber := int32(1); int(partNumber) <= u.partCount; partNumber++ {
+		if _, ok := u.completed[partNumber]; !ok {
+			parts = append(parts, partNumber)
+		}
+	}
+	return parts
+}
+
+// completedParts returns the number of parts stored so far.
+func (u *jcdsUpload) completedParts() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return len(u.completed)
+}
+
+// completedPartList returns the stored parts in ascending order, as CompleteMultipartUpload
+// requires.
+func (u *jcdsUpload) completedPartList() []types.CompletedPart {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	parts := make([]types.CompletedPart, 0, len(u.completed))
+	for _, part := range u.completed {
+		parts = append(parts, part)
+	}
+	sort.Slice(parts, func(i, j int) bool { return aws.ToInt32(parts[i].PartNumber) < aws.ToInt32(parts[j].PartNumber) })
+	return parts
+}
+
+// syncCompletedParts replaces the recorded parts with the parts JCDS 2.0 reports as stored, so that
+// a part whose response was lost is not uploaded twice and a part that was not stored is sent again.
+func (u *jcdsUpload) syncCompletedParts(ctx context.Context) error {
+	if u.client == nil || u.uploadID == "" {
+		return nil
+	}
+
+	completed := make(map[int32]types.CompletedPart)
+	var uploaded int64
+
+	paginator := s3.NewListPartsPaginator(u.client, &s3.ListPartsInput{
+		Bucket:   aws.String(u.credentials.BucketName),
+		Key:      aws.String(u.key),
+		UploadId: aws.String(u.uploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, part := range page.Parts {
+			partNumber := aws.ToInt32(part.PartNumber)
+			if partNumber < 1 || int(partNumber) > u.partCount {
+				continue
+			}
+			if _, length := u.partRange(partNumber); aws.ToInt64(part.Size) != length {
+				continue
+			}
+			completed[partNumber] = types.CompletedPart{ETag: part.ETag, PartNumber: aws.Int32(partNumber)}
+			uploaded += aws.ToInt64(part.Size)
+		}
+	}
+
+	u.mu.Lock()
+	u.completed, u.uploaded = completed, uploaded
+	u.mu.Unlock()
+
+	return nil
+}
+
+// restart discards the multipart upload so that the next attempt starts a new one.
+func (u *jcdsUpload) restart() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.uploadID = ""
+	u.completed = make(map[int32]types.CompletedPart)
+	u.uploaded = 0
+}
+
+// abort removes the parts of an upload that could not be completed. It is best effort: JCDS 2.0
+// expires abandoned uploads on its own.
+func (u *jcdsUpload) abort() {
+	if u.client == nil || u.uploadID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, _ = u.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(u.credentials.BucketName),
+		Key:      aws.String(u.key),
+		UploadId: aws.String(u.uploadID),
+	})
+}
+
+// requestCredentials obtains upload credentials for a new file from Jamf Pro and builds the S3
+// client used for the upload.
+func (u *jcdsUpload) requestCredentials(ctx context.Context) error {
+	var uploadCredentials jamfpro.ResponseJCDS2UploadCredentials
+	resp, err := u.conn.HTTP.DoRequest("POST", uriJCDS2Files, nil, &uploadCredentials)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to obtain JCDS 2.0 upload credentials: %v", err)
+	}
+
+	if uploadCredentials.Region == "" || uploadCredentials.BucketName == "" || uploadCredentials.Path == "" {
+		return errIncompleteCredentials
+	}
+
+	u.credentials = uploadCredentials
+	u.key = uploadCredentials.Path + u.fileName
+
+	return u.buildClient(ctx)
+}
+
+// renewCredentials replaces expired upload credentials. The bucket and key of the upload are kept.
+func (u *jcdsUpload) renewCredentials(ctx context.Context) error {
+	renewed, err := u.conn.RenewJCDS2Credentials()
+	if err != nil {
+		return fmt.Errorf("failed to renew JCDS 2.0 upload credentials: %v", err)
+	}
+
+	u.credentials.AccessKeyID = renewed.AccessKeyID
+	u.credentials.SecretAccessKey = renewed.SecretAccessKey
+	u.credentials.SessionToken = renewed.SessionToken
+	if renewed.Region != "" {
+		u.credentials.Region = renewed.Region
+	}
+
+	return u.buildClient(ctx)
+}
+
+// buildClient creates the S3 client for the current upload credentials.
+func (u *jcdsUpload) buildClient(ctx context.Context) error {
+	options := []func(*config.LoadOptions) error{
+		config.WithRegion(u.credentials.Region),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(u.credentials.AccessKeyID, u.credentials.SecretAccessKey, u.credentials.SessionToken)),
+	}
+	if u.httpClient != nil {
+		options = append(options, config.WithHTTPClient(u.httpClient))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return fmt.Errorf("failed to create AWS config: %v", err)
+	}
+
+	u.client = s3.NewFromConfig(cfg)
+	return nil
+}
+
+// verifyJCDSUpload waits for Jamf Pro to list the uploaded file and checks that the MD5 hash it
+// reports matches the local file. A file re-uploaded under the same name can briefly be listed with
+// the hash of the object it replaced, so a mismatch is only an error once uploadVerifyTimeout passes.
+func verifyJCDSUpload(ctx context.Context, conn *jamfpro.Client, fileName, md5Hash string) error {
+	return retry.RetryContext(ctx, uploadVerifyTimeout, func() *retry.RetryError {
+		jcdsHash, _, err := getJCDS2FileDetails(conn, fileName)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+		if jcdsHash == "" {
+			return retry.RetryableError(fmt.Errorf("file '%s' is not yet listed in JCDS 2.0 with a hash", fileName))
+		}
+		if !strings.EqualFold(jcdsHash, md5Hash) {
+			return retry.RetryableError(fmt.Errorf("integrity check failed for file '%s': JCDS 2.0 reports MD5 %s but the local file hashes to %s", fileName, jcdsHash, md5Hash))
+		}
+		return nil
+	})
+}
+
+// isPermanentUploadError reports whether an upload error cannot be resolved by trying again.
+func isPermanentUploadError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errIncompleteCredentials) {
+		return true
+	}
+
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "NoSuchBucket", "EntityTooSmall", "EntityTooLarge", "InvalidArgument", "InvalidBucketName":
+			return true
+		}
+	}
+	return false
+}
+
+// isExpiredCredentialsError reports whether S3 rejected the upload credentials.
+func isExpiredCredentialsError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "ExpiredToken", "ExpiredTokenException", "TokenRefreshRequired", "InvalidToken", "InvalidAccessKeyId", "AccessDenied":
+			return true
+		}
+	}
+	return false
+}
+
+// isNoSuchUploadError reports whether the multipart upload no longer exists and must be restarted.
+func isNoSuchUploadError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchUpload"
+}
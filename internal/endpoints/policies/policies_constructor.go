@@ -1,18 +1,528 @@
+// policies_constructor.go
 package policies
 
 import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strconv"
+
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// constructJamfProPolicy constructs a ResourcePolicy object from the provided schema data.
+// Every block declared in the resource schema is mapped onto its counterpart in the SDK
+// struct so that the payload sent to Jamf Pro mirrors what updateTerraformState reads back.
 func constructJamfProPolicy(d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
-
-	// Non computed values first
 	policy := &jamfpro.ResourcePolicy{
-		General: jamfpro.PolicySubsetGeneral{
-			Name: d.Get("name").(string),
-		},
+		General:              constructGeneral(d),
+		Scope:                constructScope(d),
+		SelfService:          constructSelfService(d),
+		PackageConfiguration: constructPackageConfiguration(d),
+		Printers:             constructPrinters(d),
+		DockItems:            constructDockItems(d),
+		AccountMaintenance:   constructAccountMaintenance(d),
+		Reboot:               constructReboot(d),
+		Maintenance:          constructMaintenance(d),
+		FilesProcesses:       constructFilesProcesses(d),
+		UserInteraction:      constructUserInteraction(d),
+		DiskEncryption:       constructDiskEncryption(d),
+	}
+
+	scripts, err := constructScripts(d)
+	if err != nil {
+		return nil, err
+	}
+	policy.Scripts = scripts
+
+	// Serialize and pretty-print the Policy object as XML for logging
+	resourceXML, err := xml.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Policy '%s' to XML: %v", policy.General.Name, err)
 	}
 
+	// Use log.Printf instead of fmt.Printf for logging within the Terraform provider context
+	log.Printf("[DEBUG] Constructed Jamf Pro Policy XML:\n%s\n", string(resourceXML))
+
 	return policy, nil
 }
+
+// constructGeneral maps the top level policy attributes onto the general subset.
+func constructGeneral(d *schema.ResourceData) jamfpro.PolicySubsetGeneral {
+	general := jamfpro.PolicySubsetGeneral{
+		Name:                       d.Get("name").(string),
+		Enabled:                    d.Get("enabled").(bool),
+		Trigger:                    d.Get("trigger").(string),
+		TriggerCheckin:             d.Get("trigger_checkin").(bool),
+		TriggerEnrollmentComplete:  d.Get("trigger_enrollment_complete").(bool),
+		TriggerLogin:               d.Get("trigger_login").(bool),
+		TriggerLogout:              d.Get("trigger_logout").(bool),
+		TriggerNetworkStateChanged: d.Get("trigger_network_state_changed").(bool),
+		TriggerStartup:             d.Get("trigger_startup").(bool),
+		TriggerOther:               d.Get("trigger_other").(string),
+		Frequency:                  d.Get("frequency").(string),
+		RetryEvent:                 d.Get("retry_event").(string),
+		RetryAttempts:              d.Get("retry_attempts").(int),
+		NotifyOnEachFailedRetry:    d.Get("notify_on_each_failed_retry").(bool),
+		LocationUserOnly:           d.Get("location_user_only").(bool),
+		TargetDrive:                d.Get("target_drive").(string),
+		Offline:                    d.Get("offline").(bool),
+		NetworkRequirements:        d.Get("network_requirements").(string),
+	}
+
+	// Category, which is cleared when the block is not set
+	general.Category = jamfpro.PolicyCategory{ID: -1, Name: "No category assigned"}
+	if len(d.Get("category").([]interface{})) != 0 {
+		general.Category = jamfpro.PolicyCategory{
+			ID:   d.Get("category.0.id").(int),
+			Name: d.Get("category.0.name").(string),
+		}
+	}
+
+	// Date Time Limitations
+	if len(d.Get("date_time_limitations").([]interface{})) != 0 {
+		general.DateTimeLimitations = jamfpro.PolicySubsetGeneralDateTimeLimitations{
+			ActivationDate:      d.Get("date_time_limitations.0.activation_date").(string),
+			ActivationDateEpoch: d.Get("date_time_limitations.0.activation_date_epoch").(int),
+			ActivationDateUTC:   d.Get("date_time_limitations.0.activation_date_utc").(string),
+			ExpirationDate:      d.Get("date_time_limitations.0.expiration_date").(string),
+			ExpirationDateEpoch: d.Get("date_time_limitations.0.expiration_date_epoch").(int),
+			ExpirationDateUTC:   d.Get("date_time_limitations.0.expiration_date_utc").(string),
+			NoExecuteStart:      d.Get("date_time_limitations.0.no_execute_start").(string),
+			NoExecuteEnd:        d.Get("date_time_limitations.0.no_execute_end").(string),
+		}
+
+		if days, ok := d.Get("date_time_limitations.0.no_execute_on").(*schema.Set); ok {
+			for _, day := range days.List() {
+				general.DateTimeLimitations.NoExecuteOn = append(general.DateTimeLimitations.NoExecuteOn, jamfpro.PolicySubsetGeneralDateTimeLimitationsNoExecuteOn{
+					Day: day.(string),
+				})
+			}
+		}
+	}
+
+	// Network Limitations
+	if len(d.Get("network_limitations").([]interface{})) != 0 {
+		general.NetworkLimitations = jamfpro.PolicySubsetGeneralNetworkLimitations{
+			MinimumNetworkConnection: d.Get("network_limitations.0.minimum_network_connection").(string),
+			AnyIPAddress:             d.Get("network_limitations.0.any_ip_address").(bool),
+			NetworkSegments:          d.Get("network_limitations.0.network_segments").(string),
+		}
+	}
+
+	// Override Default Settings
+	if len(d.Get("override_default_settings").([]interface{})) != 0 {
+		general.OverrideDefaultSettings = jamfpro.PolicySubsetGeneralOverrideSettings{
+			TargetDrive:       d.Get("override_default_settings.0.target_drive").(string),
+			DistributionPoint: d.Get("override_default_settings.0.distribution_point").(string),
+			ForceAfpSmb:       d.Get("override_default_settings.0.force_afp_smb").(bool),
+			SUS:               d.Get("override_default_settings.0.sus").(string),
+		}
+	}
+
+	// Site
+	if len(d.Get("site").([]interface{})) != 0 {
+		general.Site = jamfpro.SharedResourceSite{
+			ID:   d.Get("site.0.id").(int),
+			Name: d.Get("site.0.name").(string),
+		}
+	}
+
+	return general
+}
+
+// constructScope maps the 'scope' block, including limitations and exclusions, onto the scope subset.
+func constructScope(d *schema.ResourceData) jamfpro.PolicySubsetScope {
+	var scope jamfpro.PolicySubsetScope
+
+	if len(d.Get("scope").([]interface{})) == 0 {
+		return scope
+	}
+
+	scope.AllComputers = d.Get("scope.0.all_computers").(bool)
+
+	// Targets
+	for _, item := range getNestedItems(d, "scope.0.computers", "computer") {
+		scope.Computers = append(scope.Computers, jamfpro.PolicyDataSubsetComputer{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.computer_groups", "computer_group") {
+		scope.ComputerGroups = append(scope.ComputerGroups, jamfpro.PolicyDataSubsetComputerGroup{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.jss_users", "jss_user") {
+		scope.JSSUsers = append(scope.JSSUsers, jamfpro.PolicyDataSubsetJSSUser{ID: item["id"].(int)})
+	}
+	for _, item := range getItems(d, "scope.0.jss_user_groups") {
+		scope.JSSUserGroups = append(scope.JSSUserGroups, jamfpro.PolicyDataSubsetJSSUserGroup{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.buildings", "building") {
+		scope.Buildings = append(scope.Buildings, jamfpro.PolicyDataSubsetBuilding{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.departments", "department") {
+		scope.Departments = append(scope.Departments, jamfpro.PolicyDataSubsetDepartment{ID: item["id"].(int)})
+	}
+
+	// Limitations
+	for _, item := range getNestedItems(d, "scope.0.limitations.0.network_segments", "network_segment") {
+		scope.Limitations.NetworkSegments = append(scope.Limitations.NetworkSegments, jamfpro.PolicyDataSubsetNetworkSegment{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.limitations.0.users", "user") {
+		scope.Limitations.Users = append(scope.Limitations.Users, jamfpro.PolicyDataSubsetUser{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.limitations.0.user_groups", "user_group") {
+		scope.Limitations.UserGroups = append(scope.Limitations.UserGroups, jamfpro.PolicyDataSubsetUserGroup{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.limitations.0.ibeacons", "ibeacon") {
+		scope.Limitations.IBeacons = append(scope.Limitations.IBeacons, jamfpro.PolicyDataSubsetIBeacon{ID: item["id"].(int)})
+	}
+
+	// Exclusions
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.computers", "computer") {
+		scope.Exclusions.Computers = append(scope.Exclusions.Computers, jamfpro.PolicyDataSubsetComputer{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.computer_groups", "computer_group") {
+		scope.Exclusions.ComputerGroups = append(scope.Exclusions.ComputerGroups, jamfpro.PolicyDataSubsetComputerGroup{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.jss_users", "jss_user") {
+		scope.Exclusions.JSSUsers = append(scope.Exclusions.JSSUsers, jamfpro.PolicyDataSubsetJSSUser{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.jss_user_groups", "jss_user_group") {
+		scope.Exclusions.JSSUserGroups = append(scope.Exclusions.JSSUserGroups, jamfpro.PolicyDataSubsetJSSUserGroup{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.buildings", "building") {
+		scope.Exclusions.Buildings = append(scope.Exclusions.Buildings, jamfpro.PolicyDataSubsetBuilding{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.departments", "department") {
+		scope.Exclusions.Departments = append(scope.Exclusions.Departments, jamfpro.PolicyDataSubsetDepartment{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.network_segments", "network_segment") {
+		scope.Exclusions.NetworkSegments = append(scope.Exclusions.NetworkSegments, jamfpro.PolicyDataSubsetNetworkSegment{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.users", "user") {
+		scope.Exclusions.Users = append(scope.Exclusions.Users, jamfpro.PolicyDataSubsetUser{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.user_groups", "user_group") {
+		scope.Exclusions.UserGroups = append(scope.Exclusions.UserGroups, jamfpro.PolicyDataSubsetUserGroup{ID: item["id"].(int)})
+	}
+	for _, item := range getNestedItems(d, "scope.0.exclusions.0.ibeacons", "ibeacon") {
+		scope.Exclusions.IBeacons = append(scope.Exclusions.IBeacons, jamfpro.PolicyDataSubsetIBeacon{ID: item["id"].(int)})
+	}
+
+	return scope
+}
+
+// constructSelfService maps the 'self_service' block onto the self service subset.
+func constructSelfService(d *schema.ResourceData) jamfpro.PolicySubsetSelfService {
+	var selfService jamfpro.PolicySubsetSelfService
+
+	if len(d.Get("self_service").([]interface{})) == 0 {
+		return selfService
+	}
+
+	selfService = jamfpro.PolicySubsetSelfService{
+		UseForSelfService:           d.Get("self_service.0.use_for_self_service").(bool),
+		SelfServiceDisplayName:      d.Get("self_service.0.self_service_display_name").(string),
+		InstallButtonText:           d.Get("self_service.0.install_button_text").(string),
+		ReinstallButtonText:         d.Get("self_service.0.reinstall_button_text").(string),
+		SelfServiceDescription:      d.Get("self_service.0.self_service_description").(string),
+		ForceUsersToViewDescription: d.Get("self_service.0.force_users_to_view_description").(bool),
+		FeatureOnMainPage:           d.Get("self_service.0.feature_on_main_page").(bool),
+	}
+
+	if len(d.Get("self_service.0.self_service_icon").([]interface{})) != 0 {
+		selfService.SelfServiceIcon = jamfpro.SharedResourceSelfServiceIcon{
+			ID: d.Get("self_service.0.self_service_icon.0.id").(int),
+		}
+	}
+
+	for _, item := range getNestedItems(d, "self_service.0.self_service_categories", "category") {
+		selfService.SelfServiceCategories = append(selfService.SelfServiceCategories, jamfpro.PolicySubsetSelfServiceCategory{
+			Category: jamfpro.PolicyCategory{
+				ID:        item["id"].(int),
+				Name:      item["name"].(string),
+				DisplayIn: item["display_in"].(bool),
+				FeatureIn: item["feature_in"].(bool),
+			},
+		})
+	}
+
+	return selfService
+}
+
+// constructPackageConfiguration maps every 'package' declared under 'package_configuration.0.packages'.
+func constructPackageConfiguration(d *schema.ResourceData) jamfpro.PolicySubsetPackageConfiguration {
+	var packageConfiguration jamfpro.PolicySubsetPackageConfiguration
+
+	for _, item := range getNestedItems(d, "package_configuration.0.packages", "package") {
+		packageConfiguration.Packages = append(packageConfiguration.Packages, jamfpro.PolicySubsetPackageConfigurationPackage{
+			ID:                item["id"].(int),
+			Action:            item["action"].(string),
+			FillUserTemplate:  item["fut"].(bool),
+			FillExistingUsers: item["feu"].(bool),
+			UpdateAutorun:     item["update_autorun"].(bool),
+		})
+	}
+
+	return packageConfiguration
+}
+
+// constructScripts maps every 'script' declared under the 'scripts' block.
+func constructScripts(d *schema.ResourceData) (jamfpro.PolicySubsetScripts, error) {
+	var scripts jamfpro.PolicySubsetScripts
+
+	for _, item := range getNestedItems(d, "scripts", "script") {
+		scriptID := item["id"].(int)
+		if scriptID == 0 {
+			return scripts, fmt.Errorf("script '%s' in policy '%s' must define an 'id'", item["name"].(string), d.Get("name").(string))
+		}
+
+		scripts.Script = append(scripts.Script, jamfpro.PolicySubsetScript{
+			ID:          strconv.Itoa(scriptID),
+			Name:        item["name"].(string),
+			Priority:    item["priority"].(string),
+			Parameter4:  item["parameter4"].(string),
+			Parameter5:  item["parameter5"].(string),
+			Parameter6:  item["parameter6"].(string),
+			Parameter7:  item["parameter7"].(string),
+			Parameter8:  item["parameter8"].(string),
+			Parameter9:  item["parameter9"].(string),
+			Parameter10: item["parameter10"].(string),
+			Parameter11: item["parameter11"].(string),
+		})
+	}
+	scripts.Size = len(scripts.Script)
+
+	return scripts, nil
+}
+
+// constructPrinters maps the 'printers' block onto the printers subset.
+func constructPrinters(d *schema.ResourceData) jamfpro.PolicySubsetPrinters {
+	var printers jamfpro.PolicySubsetPrinters
+
+	if len(d.Get("printers").([]interface{})) == 0 {
+		return printers
+	}
+
+	printers.LeaveExistingDefault = d.Get("printers.0.leave_existing_default").(bool)
+	for _, item := range getItems(d, "printers.0.printer") {
+		printers.Printer = append(printers.Printer, jamfpro.PolicySubsetPrinter{
+			ID:          item["id"].(int),
+			Action:      item["action"].(string),
+			MakeDefault: item["make_default"].(bool),
+		})
+	}
+	printers.Size = len(printers.Printer)
+
+	return printers
+}
+
+// constructDockItems maps the 'dock_items' block onto the dock items subset.
+func constructDockItems(d *schema.ResourceData) jamfpro.PolicySubsetDockItems {
+	var dockItems jamfpro.PolicySubsetDockItems
+
+	for _, item := range getNestedItems(d, "dock_items", "dock_item") {
+		dockItems.DockItem = append(dockItems.DockItem, jamfpro.PolicySubsetDockItem{
+			ID:     item["id"].(int),
+			Action: item["action"].(string),
+		})
+	}
+	dockItems.Size = len(dockItems.DockItem)
+
+	return dockItems
+}
+
+// constructAccountMaintenance maps the 'account_maintenance' block, including local accounts,
+// directory bindings, the management account and the open firmware/EFI password.
+func constructAccountMaintenance(d *schema.ResourceData) jamfpro.PolicySubsetAccountMaintenance {
+	var accountMaintenance jamfpro.PolicySubsetAccountMaintenance
+
+	if len(d.Get("account_maintenance").([]interface{})) == 0 {
+		return accountMaintenance
+	}
+
+	for _, item := range getNestedItems(d, "account_maintenance.0.accounts", "account") {
+		accountMaintenance.Accounts = append(accountMaintenance.Accounts, jamfpro.PolicySubsetAccountMaintenanceAccount{
+			Action:                 item["action"].(string),
+			Username:               item["username"].(string),
+			Realname:               item["realname"].(string),
+			Password:               item["password"].(string),
+			ArchiveHomeDirectory:   item["archive_home_directory"].(bool),
+			ArchiveHomeDirectoryTo: item["archive_home_directory_to"].(string),
+			Home:                   item["home"].(string),
+			Hint:                   item["hint"].(string),
+			Picture:                item["picture"].(string),
+			Admin:                  item["admin"].(bool),
+			FilevaultEnabled:       item["filevault_enabled"].(bool),
+		})
+	}
+
+	for _, item := range getNestedItems(d, "account_maintenance.0.directory_bindings", "binding") {
+		accountMaintenance.DirectoryBindings = append(accountMaintenance.DirectoryBindings, jamfpro.PolicySubsetAccountMaintenanceDirectoryBindings{
+			ID: item["id"].(int),
+		})
+	}
+
+	if len(d.Get("account_maintenance.0.management_account").([]interface{})) != 0 {
+		accountMaintenance.ManagementAccount = jamfpro.PolicySubsetAccountMaintenanceManagementAccount{
+			Action:                d.Get("account_maintenance.0.management_account.0.action").(string),
+			ManagedPassword:       d.Get("account_maintenance.0.management_account.0.managed_password").(string),
+			ManagedPasswordLength: d.Get("account_maintenance.0.management_account.0.managed_password_length").(int),
+		}
+	}
+
+	if len(d.Get("account_maintenance.0.open_firmware_efi_password").([]interface{})) != 0 {
+		accountMaintenance.OpenFirmwareEfiPassword = jamfpro.PolicySubsetAccountMaintenanceOpenFirmwareEfiPassword{
+			OfMode:     d.Get("account_maintenance.0.open_firmware_efi_password.0.of_mode").(string),
+			OfPassword: d.Get("account_maintenance.0.open_firmware_efi_password.0.of_password").(string),
+		}
+	}
+
+	return accountMaintenance
+}
+
+// constructReboot maps the 'reboot' block onto the reboot subset. The block is a set
+// holding at most a single configuration.
+func constructReboot(d *schema.ResourceData) jamfpro.PolicySubsetReboot {
+	var reboot jamfpro.PolicySubsetReboot
+
+	rebootSet, ok := d.Get("reboot").(*schema.Set)
+	if !ok || rebootSet.Len() == 0 {
+		return reboot
+	}
+
+	item := rebootSet.List()[0].(map[string]interface{})
+	reboot = jamfpro.PolicySubsetReboot{
+		Message:                     item["message"].(string),
+		SpecifyStartup:              item["specify_startup"].(string),
+		StartupDisk:                 item["startup_disk"].(string),
+		NoUserLoggedIn:              item["no_user_logged_in"].(string),
+		UserLoggedIn:                item["user_logged_in"].(string),
+		MinutesUntilReboot:          item["minutes_until_reboot"].(int),
+		StartRebootTimerImmediately: item["start_reboot_timer_immediately"].(bool),
+		FileVault2Reboot:            item["file_vault_2_reboot"].(bool),
+	}
+
+	return reboot
+}
+
+// constructMaintenance maps the 'maintenance' block onto the maintenance subset.
+func constructMaintenance(d *schema.ResourceData) jamfpro.PolicySubsetMaintenance {
+	var maintenance jamfpro.PolicySubsetMaintenance
+
+	if len(d.Get("maintenance").([]interface{})) == 0 {
+		return maintenance
+	}
+
+	maintenance = jamfpro.PolicySubsetMaintenance{
+		Recon:                    d.Get("maintenance.0.recon").(bool),
+		ResetName:                d.Get("maintenance.0.reset_name").(bool),
+		InstallAllCachedPackages: d.Get("maintenance.0.install_all_cached_packages").(bool),
+		Heal:                     d.Get("maintenance.0.heal").(bool),
+		Prebindings:              d.Get("maintenance.0.prebindings").(bool),
+		Permissions:              d.Get("maintenance.0.permissions").(bool),
+		Byhost:                   d.Get("maintenance.0.byhost").(bool),
+		SystemCache:              d.Get("maintenance.0.system_cache").(bool),
+		UserCache:                d.Get("maintenance.0.user_cache").(bool),
+		Verify:                   d.Get("maintenance.0.verify").(bool),
+	}
+
+	return maintenance
+}
+
+// constructFilesProcesses maps the 'files_processes' block onto the files and processes subset.
+func constructFilesProcesses(d *schema.ResourceData) jamfpro.PolicySubsetFilesProcesses {
+	var filesProcesses jamfpro.PolicySubsetFilesProcesses
+
+	if len(d.Get("files_processes").([]interface{})) == 0 {
+		return filesProcesses
+	}
+
+	filesProcesses = jamfpro.PolicySubsetFilesProcesses{
+		SearchByPath:         d.Get("files_processes.0.search_by_path").(string),
+		DeleteFile:           d.Get("files_processes.0.delete_file").(bool),
+		LocateFile:           d.Get("files_processes.0.locate_file").(string),
+		UpdateLocateDatabase: d.Get("files_processes.0.update_locate_database").(bool),
+		SpotlightSearch:      d.Get("files_processes.0.spotlight_search").(string),
+		SearchForProcess:     d.Get("files_processes.0.search_for_process").(string),
+		KillProcess:          d.Get("files_processes.0.kill_process").(bool),
+		RunCommand:           d.Get("files_processes.0.run_command").(string),
+	}
+
+	return filesProcesses
+}
+
+// constructUserInteraction maps the 'user_interaction' block onto the user interaction subset.
+func constructUserInteraction(d *schema.ResourceData) jamfpro.PolicySubsetUserInteraction {
+	var userInteraction jamfpro.PolicySubsetUserInteraction
+
+	if len(d.Get("user_interaction").([]interface{})) == 0 {
+		return userInteraction
+	}
+
+	userInteraction = jamfpro.PolicySubsetUserInteraction{
+		MessageStart:          d.Get("user_interaction.0.message_start").(string),
+		AllowUserToDefer:      d.Get("user_interaction.0.allow_user_to_defer").(bool),
+		AllowDeferralUntilUtc: d.Get("user_interaction.0.allow_deferral_until_utc").(string),
+		AllowDeferralMinutes:  d.Get("user_interaction.0.allow_deferral_minutes").(int),
+		MessageFinish:         d.Get("user_interaction.0.message_finish").(string),
+	}
+
+	return userInteraction
+}
+
+// constructDiskEncryption maps the 'disk_encryption' block onto the disk encryption subset.
+func constructDiskEncryption(d *schema.ResourceData) jamfpro.PolicySubsetDiskEncryption {
+	var diskEncryption jamfpro.PolicySubsetDiskEncryption
+
+	if len(d.Get("disk_encryption").([]interface{})) == 0 {
+		return diskEncryption
+	}
+
+	diskEncryption = jamfpro.PolicySubsetDiskEncryption{
+		Action:                                 d.Get("disk_encryption.0.action").(string),
+		DiskEncryptionConfigurationID:          d.Get("disk_encryption.0.disk_encryption_configuration_id").(int),
+		AuthRestart:                            d.Get("disk_encryption.0.auth_restart").(bool),
+		RemediateKeyType:                       d.Get("disk_encryption.0.remediate_key_type").(string),
+		RemediateDiskEncryptionConfigurationID: d.Get("disk_encryption.0.remediate_disk_encryption_configuration_id").(int),
+	}
+
+	return diskEncryption
+}
+
+// getItems returns the elements of the list block found at path as maps.
+func getItems(d *schema.ResourceData, path string) []map[string]interface{} {
+	var items []map[string]interface{}
+
+	list, ok := d.Get(path).([]interface{})
+	if !ok {
+		return items
+	}
+
+	for _, v := range list {
+		if item, ok := v.(map[string]interface{}); ok {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// getNestedItems flattens the list-of-wrappers shape used throughout the policy schema
+// (e.g. 'computers { computer { ... } }') into the inner elements found under key.
+func getNestedItems(d *schema.ResourceData, path string, key string) []map[string]interface{} {
+	var items []map[string]interface{}
+
+	for _, wrapper := range getItems(d, path) {
+		inner, ok := wrapper[key].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, v := range inner {
+			if item, ok := v.(map[string]interface{}); ok {
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items
+}
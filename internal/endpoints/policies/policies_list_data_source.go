@@ -0,0 +1,49 @@
+// policies_list_data_source.go
+package policies
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProPoliciesList lists the Jamf Pro policies, optionally filtered by name or site.
+func DataSourceJamfProPoliciesList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro Policies",
+		Lister:     listPolicies,
+		SiteGetter: getPolicySiteID,
+	}
+	return list.DataSource()
+}
+
+// listPolicies returns the ID and name of every Jamf Pro policy.
+func listPolicies(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Policy))
+	for _, policy := range response.Policy {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(policy.ID), Name: policy.Name})
+	}
+	return items, nil
+}
+
+// getPolicySiteID returns the ID of the site a policy belongs to.
+func getPolicySiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetPolicyByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.General.Site), nil
+}
@@ -3,8 +3,12 @@ package policies
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
 	util "github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/type_assertion"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,12 +22,23 @@ func ResourceJamfProPolicies() *schema.Resource {
 		ReadContext:   ResourceJamfProPoliciesRead,
 		UpdateContext: ResourceJamfProPoliciesUpdate,
 		DeleteContext: ResourceJamfProPoliciesDelete,
+		CustomizeDiff: defaults.CustomizeDiff(
+			defaults.Field{Key: "site", Kind: defaults.Site, Format: defaults.Block, Required: true},
+			defaults.Field{Key: "category", Kind: defaults.Category, Format: defaults.Block, Unset: []interface{}{}},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 			Read:   schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetPolicyByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.General.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -155,7 +170,7 @@ func ResourceJamfProPolicies() *schema.Resource {
 			"category": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Category to add the policy to.",
+				Description: "Category to add the policy to. Defaults to the provider's 'default_category', or no category when it is not set.",
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -163,7 +178,7 @@ func ResourceJamfProPolicies() *schema.Resource {
 							Type:        schema.TypeInt,
 							Optional:    true,
 							Description: "The category ID assigned to the jamf pro policy. Defaults to '-1' aka not used.",
-							Default:     "-1",
+							Default:     -1,
 						},
 						"name": {
 							Type:        schema.TypeString,
@@ -308,8 +323,9 @@ func ResourceJamfProPolicies() *schema.Resource {
 			},
 			"site": {
 				Type:        schema.TypeList,
-				Required:    true,
-				Description: "Jamf Pro Site-related settings of the policy.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Jamf Pro Site-related settings of the policy. Required unless the provider sets 'default_site', which applies when this is not set.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -1657,7 +1673,7 @@ func ResourceJamfProPolicies() *schema.Resource {
 							Type:        schema.TypeInt,
 							Optional:    true,
 							Description: "Number of minutes after the user was first prompted by the policy at which the policy runs and deferrals are prohibited",
-							Default:     "0",
+							Default:     0,
 						},
 						"message_finish": {
 							Type:        schema.TypeString,
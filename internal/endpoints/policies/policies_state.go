@@ -0,0 +1,557 @@
+// policies_state.go
+package policies
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateTerraformState maps a Jamf Pro policy response back onto the resource schema. It is the
+// inverse of constructJamfProPolicy: every block populated by the constructor is read back in the
+// same shape so that a create or update followed by a read converges without a diff.
+func updateTerraformState(d *schema.ResourceData, resp *jamfpro.ResourcePolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// General
+	generalAttributes := map[string]interface{}{
+		"name":                          resp.General.Name,
+		"enabled":                       resp.General.Enabled,
+		"trigger":                       resp.General.Trigger,
+		"trigger_checkin":               resp.General.TriggerCheckin,
+		"trigger_enrollment_complete":   resp.General.TriggerEnrollmentComplete,
+		"trigger_login":                 resp.General.TriggerLogin,
+		"trigger_logout":                resp.General.TriggerLogout,
+		"trigger_network_state_changed": resp.General.TriggerNetworkStateChanged,
+		"trigger_startup":               resp.General.TriggerStartup,
+		"trigger_other":                 resp.General.TriggerOther,
+		"frequency":                     resp.General.Frequency,
+		"retry_event":                   resp.General.RetryEvent,
+		"retry_attempts":                resp.General.RetryAttempts,
+		"notify_on_each_failed_retry":   resp.General.NotifyOnEachFailedRetry,
+		"location_user_only":            resp.General.LocationUserOnly,
+		"target_drive":                  resp.General.TargetDrive,
+		"offline":                       resp.General.Offline,
+		"network_requirements":          resp.General.NetworkRequirements,
+		"category":                      policyCategory(d, resp.General.Category),
+		"date_time_limitations": []interface{}{map[string]interface{}{
+			"activation_date":       resp.General.DateTimeLimitations.ActivationDate,
+			"activation_date_epoch": resp.General.DateTimeLimitations.ActivationDateEpoch,
+			"activation_date_utc":   resp.General.DateTimeLimitations.ActivationDateUTC,
+			"expiration_date":       resp.General.DateTimeLimitations.ExpirationDate,
+			"expiration_date_epoch": resp.General.DateTimeLimitations.ExpirationDateEpoch,
+			"expiration_date_utc":   resp.General.DateTimeLimitations.ExpirationDateUTC,
+			"no_execute_on": func() []interface{} {
+				days := make([]interface{}, len(resp.General.DateTimeLimitations.NoExecuteOn))
+				for i, noExecOn := range resp.General.DateTimeLimitations.NoExecuteOn {
+					days[i] = noExecOn.Day
+				}
+				return days
+			}(),
+			"no_execute_start": resp.General.DateTimeLimitations.NoExecuteStart,
+			"no_execute_end":   resp.General.DateTimeLimitations.NoExecuteEnd,
+		}},
+		"network_limitations": []interface{}{map[string]interface{}{
+			"minimum_network_connection": resp.General.NetworkLimitations.MinimumNetworkConnection,
+			"any_ip_address":             resp.General.NetworkLimitations.AnyIPAddress,
+			"network_segments":           resp.General.NetworkLimitations.NetworkSegments,
+		}},
+		"override_default_settings": []interface{}{map[string]interface{}{
+			"target_drive":       resp.General.OverrideDefaultSettings.TargetDrive,
+			"distribution_point": resp.General.OverrideDefaultSettings.DistributionPoint,
+			"force_afp_smb":      resp.General.OverrideDefaultSettings.ForceAfpSmb,
+			"sus":                resp.General.OverrideDefaultSettings.SUS,
+		}},
+		"site": []interface{}{map[string]interface{}{
+			"id":   resp.General.Site.ID,
+			"name": resp.General.Site.Name,
+		}},
+	}
+
+	for key, value := range generalAttributes {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	// Scope
+	if err := d.Set("scope", []interface{}{stateScope(d, resp)}); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// Self Service
+	if err := d.Set("self_service", []interface{}{stateSelfService(resp)}); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// Dock Items (computed, always stated)
+	dockItems := make([]interface{}, 0, len(resp.DockItems.DockItem))
+	for _, dockItem := range resp.DockItems.DockItem {
+		dockItems = append(dockItems, map[string]interface{}{
+			"id":     dockItem.ID,
+			"name":   dockItem.Name,
+			"action": dockItem.Action,
+		})
+	}
+	if err := d.Set("dock_items", []interface{}{map[string]interface{}{"dock_item": dockItems}}); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// Disk Encryption (computed, always stated)
+	if err := d.Set("disk_encryption", []interface{}{map[string]interface{}{
+		"action":                           resp.DiskEncryption.Action,
+		"disk_encryption_configuration_id": resp.DiskEncryption.DiskEncryptionConfigurationID,
+		"auth_restart":                     resp.DiskEncryption.AuthRestart,
+		"remediate_key_type":               resp.DiskEncryption.RemediateKeyType,
+		"remediate_disk_encryption_configuration_id": resp.DiskEncryption.RemediateDiskEncryptionConfigurationID,
+	}}); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// Optional blocks which are only stated when configured or when Jamf Pro holds non default values
+	optionalBlocks := map[string]map[string]interface{}{
+		"package_configuration": statePackageConfiguration(resp),
+		"scripts":               stateScripts(resp),
+		"printers":              statePrinters(resp),
+		"account_maintenance":   stateAccountMaintenance(d, resp),
+		"reboot":                stateReboot(resp),
+		"maintenance":           stateMaintenance(resp),
+		"files_processes":       stateFilesProcesses(resp),
+		"user_interaction":      stateUserInteraction(resp),
+	}
+
+	resourceSchema := ResourceJamfProPolicies().Schema
+	for key, block := range optionalBlocks {
+		elem := resourceSchema[key].Elem.(*schema.Resource)
+		if !blockIsConfigured(d, key) && isDefaultBlock(elem, block) {
+			continue
+		}
+		pruneDefaultBlocks(d, key, elem, block)
+		if err := d.Set(key, []interface{}{block}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// stateScope builds the 'scope' block from the policy response.
+func stateScope(d *schema.ResourceData, resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	scope := map[string]interface{}{
+		"all_computers":   resp.Scope.AllComputers,
+		"computers":       wrapItems("computer", computersToState(resp.Scope.Computers)),
+		"computer_groups": wrapItems("computer_group", idNamesToState(resp.Scope.ComputerGroups)),
+		"jss_users":       wrapItems("jss_user", idNamesToState(resp.Scope.JSSUsers)),
+		"jss_user_groups": idNamesToState(resp.Scope.JSSUserGroups),
+		"buildings":       wrapItems("building", idNamesToState(resp.Scope.Buildings)),
+		"departments":     wrapItems("department", idNamesToState(resp.Scope.Departments)),
+	}
+
+	limitations := map[string]interface{}{
+		"network_segments": wrapItems("network_segment", networkSegmentsToState(resp.Scope.Limitations.NetworkSegments)),
+		"users":            wrapItems("user", idNamesToState(resp.Scope.Limitations.Users)),
+		"user_groups":      wrapItems("user_group", idNamesToState(resp.Scope.Limitations.UserGroups)),
+		"ibeacons":         wrapItems("ibeacon", idNamesToState(resp.Scope.Limitations.IBeacons)),
+	}
+	if blockIsConfigured(d, "scope.0.limitations") || !allEmpty(limitations) {
+		scope["limitations"] = []interface{}{limitations}
+	}
+
+	exclusions := map[string]interface{}{
+		"computers":        wrapItems("computer", computersToState(resp.Scope.Exclusions.Computers)),
+		"computer_groups":  wrapItems("computer_group", idNamesToState(resp.Scope.Exclusions.ComputerGroups)),
+		"jss_users":        wrapItems("jss_user", idNamesToState(resp.Scope.Exclusions.JSSUsers)),
+		"jss_user_groups":  wrapItems("jss_user_group", idNamesToState(resp.Scope.Exclusions.JSSUserGroups)),
+		"buildings":        wrapItems("building", idNamesToState(resp.Scope.Exclusions.Buildings)),
+		"departments":      wrapItems("department", idNamesToState(resp.Scope.Exclusions.Departments)),
+		"network_segments": wrapItems("network_segment", networkSegmentsToState(resp.Scope.Exclusions.NetworkSegments)),
+		"users":            wrapItems("user", idNamesToState(resp.Scope.Exclusions.Users)),
+		"user_groups":      wrapItems("user_group", idNamesToState(resp.Scope.Exclusions.UserGroups)),
+		"ibeacons":         wrapItems("ibeacon", idNamesToState(resp.Scope.Exclusions.IBeacons)),
+	}
+	if blockIsConfigured(d, "scope.0.exclusions") || !allEmpty(exclusions) {
+		scope["exclusions"] = []interface{}{exclusions}
+	}
+
+	return scope
+}
+
+// stateSelfService builds the 'self_service' block from the policy response.
+func stateSelfService(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	categories := make([]interface{}, 0, len(resp.SelfService.SelfServiceCategories))
+	for _, cat := range resp.SelfService.SelfServiceCategories {
+		categories = append(categories, map[string]interface{}{
+			"id":         cat.Category.ID,
+			"name":       cat.Category.Name,
+			"display_in": cat.Category.DisplayIn,
+			"feature_in": cat.Category.FeatureIn,
+		})
+	}
+
+	selfServiceCategories := []interface{}{}
+	if len(categories) > 0 {
+		selfServiceCategories = []interface{}{map[string]interface{}{"category": categories}}
+	}
+
+	return map[string]interface{}{
+		"use_for_self_service":            resp.SelfService.UseForSelfService,
+		"self_service_display_name":       resp.SelfService.SelfServiceDisplayName,
+		"install_button_text":             resp.SelfService.InstallButtonText,
+		"reinstall_button_text":           resp.SelfService.ReinstallButtonText,
+		"self_service_description":        resp.SelfService.SelfServiceDescription,
+		"force_users_to_view_description": resp.SelfService.ForceUsersToViewDescription,
+		"self_service_icon": []interface{}{map[string]interface{}{
+			"id":       resp.SelfService.SelfServiceIcon.ID,
+			"filename": resp.SelfService.SelfServiceIcon.Filename,
+			"uri":      resp.SelfService.SelfServiceIcon.URI,
+		}},
+		"feature_on_main_page":    resp.SelfService.FeatureOnMainPage,
+		"self_service_categories": selfServiceCategories,
+	}
+}
+
+// statePackageConfiguration builds the 'package_configuration' block, grouping every package
+// under a single 'packages' element.
+func statePackageConfiguration(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	packages := make([]interface{}, 0, len(resp.PackageConfiguration.Packages))
+	for _, packageItem := range resp.PackageConfiguration.Packages {
+		packages = append(packages, map[string]interface{}{
+			"id":             packageItem.ID,
+			"name":           packageItem.Name,
+			"action":         packageItem.Action,
+			"fut":            packageItem.FillUserTemplate,
+			"feu":            packageItem.FillExistingUsers,
+			"update_autorun": packageItem.UpdateAutorun,
+		})
+	}
+
+	return map[string]interface{}{
+		"packages": wrapAll("package", packages),
+	}
+}
+
+// stateScripts builds the 'scripts' block, grouping every script under a single element.
+func stateScripts(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	scripts := make([]interface{}, 0, len(resp.Scripts.Script))
+	for _, scriptItem := range resp.Scripts.Script {
+		scriptID, _ := strconv.Atoi(scriptItem.ID)
+		scripts = append(scripts, map[string]interface{}{
+			"id":          scriptID,
+			"name":        scriptItem.Name,
+			"priority":    scriptItem.Priority,
+			"parameter4":  scriptItem.Parameter4,
+			"parameter5":  scriptItem.Parameter5,
+			"parameter6":  scriptItem.Parameter6,
+			"parameter7":  scriptItem.Parameter7,
+			"parameter8":  scriptItem.Parameter8,
+			"parameter9":  scriptItem.Parameter9,
+			"parameter10": scriptItem.Parameter10,
+			"parameter11": scriptItem.Parameter11,
+		})
+	}
+
+	return map[string]interface{}{
+		"script": scripts,
+	}
+}
+
+// statePrinters builds the 'printers' block from the policy response.
+func statePrinters(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	printers := make([]interface{}, 0, len(resp.Printers.Printer))
+	for _, printerItem := range resp.Printers.Printer {
+		printers = append(printers, map[string]interface{}{
+			"id":           printerItem.ID,
+			"name":         printerItem.Name,
+			"action":       printerItem.Action,
+			"make_default": printerItem.MakeDefault,
+		})
+	}
+
+	return map[string]interface{}{
+		"leave_existing_default": resp.Printers.LeaveExistingDefault,
+		"printer":                printers,
+	}
+}
+
+// stateAccountMaintenance builds the 'account_maintenance' block. Jamf Pro only returns hashes
+// of the account and firmware passwords, so the configured values are carried over from state.
+func stateAccountMaintenance(d *schema.ResourceData, resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	configuredAccounts := getNestedItems(d, "account_maintenance.0.accounts", "account")
+
+	accounts := make([]interface{}, 0, len(resp.AccountMaintenance.Accounts))
+	for i, account := range resp.AccountMaintenance.Accounts {
+		password := account.Password
+		if password == "" && i < len(configuredAccounts) {
+			password = configuredAccounts[i]["password"].(string)
+		}
+		accounts = append(accounts, map[string]interface{}{
+			"action":                    account.Action,
+			"username":                  account.Username,
+			"realname":                  account.Realname,
+			"password":                  password,
+			"archive_home_directory":    account.ArchiveHomeDirectory,
+			"archive_home_directory_to": account.ArchiveHomeDirectoryTo,
+			"home":                      account.Home,
+			"hint":                      account.Hint,
+			"picture":                   account.Picture,
+			"admin":                     account.Admin,
+			"filevault_enabled":         account.FilevaultEnabled,
+		})
+	}
+
+	bindings := make([]interface{}, 0, len(resp.AccountMaintenance.DirectoryBindings))
+	for _, binding := range resp.AccountMaintenance.DirectoryBindings {
+		bindings = append(bindings, map[string]interface{}{
+			"id":   binding.ID,
+			"name": binding.Name,
+		})
+	}
+
+	managedPassword := resp.AccountMaintenance.ManagementAccount.ManagedPassword
+	if managedPassword == "" {
+		managedPassword, _ = d.Get("account_maintenance.0.management_account.0.managed_password").(string)
+	}
+
+	ofPassword := resp.AccountMaintenance.OpenFirmwareEfiPassword.OfPassword
+	if ofPassword == "" {
+		ofPassword, _ = d.Get("account_maintenance.0.open_firmware_efi_password.0.of_password").(string)
+	}
+
+	return map[string]interface{}{
+		"accounts":           wrapAll("account", accounts),
+		"directory_bindings": wrapAll("binding", bindings),
+		"management_account": []interface{}{map[string]interface{}{
+			"action":                  resp.AccountMaintenance.ManagementAccount.Action,
+			"managed_password":        managedPassword,
+			"managed_password_length": resp.AccountMaintenance.ManagementAccount.ManagedPasswordLength,
+		}},
+		"open_firmware_efi_password": []interface{}{map[string]interface{}{
+			"of_mode":     resp.AccountMaintenance.OpenFirmwareEfiPassword.OfMode,
+			"of_password": ofPassword,
+		}},
+	}
+}
+
+// stateReboot builds the 'reboot' block from the policy response.
+func stateReboot(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	return map[string]interface{}{
+		"message":                        resp.Reboot.Message,
+		"specify_startup":                resp.Reboot.SpecifyStartup,
+		"startup_disk":                   resp.Reboot.StartupDisk,
+		"no_user_logged_in":              resp.Reboot.NoUserLoggedIn,
+		"user_logged_in":                 resp.Reboot.UserLoggedIn,
+		"minutes_until_reboot":           resp.Reboot.MinutesUntilReboot,
+		"start_reboot_timer_immediately": resp.Reboot.StartRebootTimerImmediately,
+		"file_vault_2_reboot":            resp.Reboot.FileVault2Reboot,
+	}
+}
+
+// stateMaintenance builds the 'maintenance' block from the policy response.
+func stateMaintenance(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	return map[string]interface{}{
+		"recon":                       resp.Maintenance.Recon,
+		"reset_name":                  resp.Maintenance.ResetName,
+		"install_all_cached_packages": resp.Maintenance.InstallAllCachedPackages,
+		"heal":                        resp.Maintenance.Heal,
+		"prebindings":                 resp.Maintenance.Prebindings,
+		"permissions":                 resp.Maintenance.Permissions,
+		"byhost":                      resp.Maintenance.Byhost,
+		"system_cache":                resp.Maintenance.SystemCache,
+		"user_cache":                  resp.Maintenance.UserCache,
+		"verify":                      resp.Maintenance.Verify,
+	}
+}
+
+// stateFilesProcesses builds the 'files_processes' block from the policy response.
+func stateFilesProcesses(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	return map[string]interface{}{
+		"search_by_path":         resp.FilesProcesses.SearchByPath,
+		"delete_file":            resp.FilesProcesses.DeleteFile,
+		"locate_file":            resp.FilesProcesses.LocateFile,
+		"update_locate_database": resp.FilesProcesses.UpdateLocateDatabase,
+		"spotlight_search":       resp.FilesProcesses.SpotlightSearch,
+		"search_for_process":     resp.FilesProcesses.SearchForProcess,
+		"kill_process":           resp.FilesProcesses.KillProcess,
+		"run_command":            resp.FilesProcesses.RunCommand,
+	}
+}
+
+// stateUserInteraction builds the 'user_interaction' block from the policy response.
+func stateUserInteraction(resp *jamfpro.ResourcePolicy) map[string]interface{} {
+	return map[string]interface{}{
+		"message_start":            resp.UserInteraction.MessageStart,
+		"allow_user_to_defer":      resp.UserInteraction.AllowUserToDefer,
+		"allow_deferral_until_utc": resp.UserInteraction.AllowDeferralUntilUtc,
+		"allow_deferral_minutes":   resp.UserInteraction.AllowDeferralMinutes,
+		"message_finish":           resp.UserInteraction.MessageFinish,
+	}
+}
+
+// idNamesToState converts any SDK subset exposing ID and Name fields into state maps.
+func idNamesToState[T jamfpro.PolicyDataSubsetComputerGroup | jamfpro.PolicyDataSubsetJSSUser |
+	jamfpro.PolicyDataSubsetJSSUserGroup | jamfpro.PolicyDataSubsetBuilding | jamfpro.PolicyDataSubsetDepartment |
+	jamfpro.PolicyDataSubsetUser | jamfpro.PolicyDataSubsetUserGroup | jamfpro.PolicyDataSubsetIBeacon](items []T) []interface{} {
+	out := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		v := reflect.ValueOf(item)
+		out = append(out, map[string]interface{}{
+			"id":   int(v.FieldByName("ID").Int()),
+			"name": v.FieldByName("Name").String(),
+		})
+	}
+	return out
+}
+
+// computersToState converts scoped computers into state maps.
+func computersToState(computers []jamfpro.PolicyDataSubsetComputer) []interface{} {
+	out := make([]interface{}, 0, len(computers))
+	for _, computer := range computers {
+		out = append(out, map[string]interface{}{
+			"id":   computer.ID,
+			"name": computer.Name,
+			"udid": computer.UDID,
+		})
+	}
+	return out
+}
+
+// networkSegmentsToState converts scoped network segments into state maps.
+func networkSegmentsToState(segments []jamfpro.PolicyDataSubsetNetworkSegment) []interface{} {
+	out := make([]interface{}, 0, len(segments))
+	for _, segment := range segments {
+		out = append(out, map[string]interface{}{
+			"id":   segment.ID,
+			"name": segment.Name,
+			"uid":  segment.UID,
+		})
+	}
+	return out
+}
+
+// wrapItems places each item in its own single element wrapper, e.g. one
+// 'computers { computer { ... } }' block per scoped computer.
+func wrapItems(key string, items []interface{}) []interface{} {
+	out := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		out = append(out, map[string]interface{}{key: []interface{}{item}})
+	}
+	return out
+}
+
+// wrapAll places every item under a single wrapper element, e.g. one
+// 'packages { package { ... } package { ... } }' block.
+func wrapAll(key string, items []interface{}) []interface{} {
+	if len(items) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{key: items}}
+}
+
+// allEmpty reports whether every list within the block is empty.
+func allEmpty(block map[string]interface{}) bool {
+	for _, v := range block {
+		if list, ok := v.([]interface{}); ok && len(list) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// blockIsConfigured reports whether the block at path currently holds at least one element.
+func blockIsConfigured(d *schema.ResourceData, path string) bool {
+	switch v := d.Get(path).(type) {
+	case []interface{}:
+		return len(v) > 0
+	case *schema.Set:
+		return v.Len() > 0
+	}
+	return false
+}
+
+// pruneDefaultBlocks empties the single element sub-blocks of block which are neither configured
+// nor hold non default values, so that optional sub-blocks omitted from the configuration are
+// not reported as drift.
+func pruneDefaultBlocks(d *schema.ResourceData, path string, elem *schema.Resource, block map[string]interface{}) {
+	for key, attr := range elem.Schema {
+		nested, ok := attr.Elem.(*schema.Resource)
+		if !ok || attr.Computed {
+			continue
+		}
+		list, ok := block[key].([]interface{})
+		if !ok || len(list) != 1 {
+			continue
+		}
+		nestedBlock, ok := list[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if !blockIsConfigured(d, path+".0."+key) && isDefaultBlock(nested, nestedBlock) {
+			block[key] = []interface{}{}
+		}
+	}
+}
+
+// isDefaultBlock reports whether every attribute of block matches the default declared in the
+// schema or the zero value, which Jamf Pro reports for settings that were never set, and every
+// nested block is empty.
+func isDefaultBlock(elem *schema.Resource, block map[string]interface{}) bool {
+	for key, attr := range elem.Schema {
+		value, ok := block[key]
+		if !ok {
+			continue
+		}
+
+		switch attr.Type {
+		case schema.TypeList, schema.TypeSet:
+			if list, ok := value.([]interface{}); ok && len(list) > 0 {
+				if nested, ok := attr.Elem.(*schema.Resource); ok && len(list) == 1 {
+					if nestedBlock, ok := list[0].(map[string]interface{}); ok && isDefaultBlock(nested, nestedBlock) {
+						continue
+					}
+				}
+				return false
+			}
+		default:
+			if !reflect.DeepEqual(value, schemaDefault(attr)) && !reflect.DeepEqual(value, zeroValue(attr)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// schemaDefault returns the default value of a primitive attribute, falling back to its zero value.
+func schemaDefault(attr *schema.Schema) interface{} {
+	if attr.Default != nil {
+		return attr.Default
+	}
+	return zeroValue(attr)
+}
+
+// zeroValue returns the zero value of a primitive attribute.
+func zeroValue(attr *schema.Schema) interface{} {
+	switch attr.Type {
+	case schema.TypeBool:
+		return false
+	case schema.TypeInt:
+		return 0
+	case schema.TypeString:
+		return ""
+	}
+	return nil
+}
+
+// policyCategory returns the category block of a policy. It is empty when Jamf Pro reports the
+// policy with the category ID -1, 'No category assigned', unless the block is set to that category.
+func policyCategory(d *schema.ResourceData, category jamfpro.PolicyCategory) []interface{} {
+	if category.ID <= 0 && len(d.Get("category").([]interface{})) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"id":   category.ID,
+		"name": category.Name,
+	}}
+}
@@ -0,0 +1,34 @@
+// printers_list_data_source.go
+package printers
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProPrintersList lists the Jamf Pro printers, optionally filtered by name.
+func DataSourceJamfProPrintersList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Printers",
+		Lister: listPrinters,
+	}
+	return list.DataSource()
+}
+
+// listPrinters returns the ID and name of every Jamf Pro printer.
+func listPrinters(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetPrinters()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Printer))
+	for _, printer := range response.Printer {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(printer.ID), Name: printer.Name})
+	}
+	return items, nil
+}
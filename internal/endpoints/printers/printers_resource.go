@@ -5,14 +5,17 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,16 +27,23 @@ func ResourceJamfProPrinters() *schema.Resource {
 		ReadContext:   ResourceJamfProPrintersRead,
 		UpdateContext: ResourceJamfProPrintersUpdate,
 		DeleteContext: ResourceJamfProPrintersDelete,
-		CustomizeDiff: validateJamfProResourcePrinterDataFields,
+		CustomizeDiff: customdiff.All(
+			validateJamfProResourcePrinterDataFields,
+			defaults.CustomizeDiff(defaults.Field{Key: "category", Kind: defaults.Category, Format: defaults.Name, Unset: "No category assigned"}),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 			Read:   schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetPrinterByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -48,8 +58,8 @@ func ResourceJamfProPrinters() *schema.Resource {
 			"category": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "No category assigned",
-				Description: "The jamf pro category of the printer.",
+				Computed:    true,
+				Description: "The jamf pro category of the printer. Defaults to the provider's 'default_category', or 'No category assigned' when it is not set.",
 			},
 			"uri": {
 				Type:        schema.TypeString,
@@ -140,14 +150,14 @@ func ResourceJamfProPrintersCreate(ctx context.Context, d *schema.ResourceData,
 		var apiErr error
 		creationResponse, apiErr = conn.CreatePrinter(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Printer '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Printer '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -201,7 +211,7 @@ func ResourceJamfProPrintersRead(ctx context.Context, d *schema.ResourceData, me
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -213,7 +223,7 @@ func ResourceJamfProPrintersRead(ctx context.Context, d *schema.ResourceData, me
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Printer with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -274,14 +284,14 @@ func ResourceJamfProPrintersUpdate(ctx context.Context, d *schema.ResourceData,
 		_, apiErr := conn.UpdatePrinterByID(resourceIDInt, resource)
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Printer '%s' (ID: %d) after retries: %v", resource.Name, resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Printer '%s' (ID: %d)", resource.Name, resourceIDInt))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -321,8 +331,8 @@ func ResourceJamfProPrintersDelete(ctx context.Context, d *schema.ResourceData,
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeletePrinterByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -330,7 +340,7 @@ func ResourceJamfProPrintersDelete(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Printer '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Printer '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -0,0 +1,32 @@
+// scripts_list_data_source.go
+package scripts
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProScriptsList lists the Jamf Pro scripts, optionally filtered by name.
+func DataSourceJamfProScriptsList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Scripts",
+		Lister: listScripts,
+	}
+	return list.DataSource()
+}
+
+// listScripts returns the ID and name of every Jamf Pro script.
+func listScripts(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetScripts("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Results))
+	for _, script := range response.Results {
+		items = append(items, crud.ListItem{ID: script.ID, Name: script.Name})
+	}
+	return items, nil
+}
@@ -30,6 +30,11 @@ func constructJamfProScript(d *schema.ResourceData) (*jamfpro.ResourceScript, er
 		Parameter11:    d.Get("parameter11").(string),
 	}
 
+	// A script without a category name is taken out of its category
+	if script.CategoryName == "" {
+		script.CategoryId = "-1"
+	}
+
 	// Directly assign script_contents as a string
 	if scriptContent, ok := d.GetOk("script_contents"); ok {
 		script.ScriptContents = scriptContent.(string)
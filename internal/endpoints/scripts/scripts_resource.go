@@ -4,11 +4,13 @@ package scripts
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,15 +26,23 @@ func ResourceJamfProScripts() *schema.Resource {
 		ReadContext:   ResourceJamfProScriptsRead,
 		UpdateContext: ResourceJamfProScriptsUpdate,
 		DeleteContext: ResourceJamfProScriptsDelete,
+		CustomizeDiff: defaults.CustomizeDiff(
+			defaults.Field{Key: "category_name", Kind: defaults.Category, Format: defaults.Name, Unset: ""},
+			defaults.Field{Key: "category_id", Kind: defaults.Category, Format: defaults.ID, ConfiguredBy: "category_name", Unset: "-1"},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 			Read:   schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(30 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetScriptByName(name)
+			if err != nil {
+				return "", err
+			}
+			return resource.ID, nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -48,12 +58,12 @@ func ResourceJamfProScripts() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "Name of the category to add the script to.",
+				Description: "Name of the category to add the script to. Defaults to the provider's 'default_category', or no category when it is not set.",
 			},
 			"category_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The Jamf Pro unique identifier (ID) of the category.",
+				Description: "The Jamf Pro unique identifier (ID) of the category, or '-1' when the script has no category.",
 			},
 			"info": {
 				Type:        schema.TypeString,
@@ -154,14 +164,14 @@ func ResourceJamfProScriptsCreate(ctx context.Context, d *schema.ResourceData, m
 		var apiErr error
 		creationResponse, apiErr = conn.CreateScript(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Script '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro Script '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -208,7 +218,7 @@ func ResourceJamfProScriptsRead(ctx context.Context, d *schema.ResourceData, met
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -220,15 +230,21 @@ func ResourceJamfProScriptsRead(ctx context.Context, d *schema.ResourceData, met
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro Script with ID '%s'", resourceID))
+	}
+
+	// Jamf Pro reports scripts without a category with the category ID -1
+	categoryName, categoryID := resource.CategoryName, resource.CategoryId
+	if categoryID == "" || categoryID == "-1" {
+		categoryName, categoryID = "", "-1"
 	}
 
 	// Update the Terraform state with the fetched data
 	resourceData := map[string]interface{}{
 		"id":              resource.ID,
 		"name":            resource.Name,
-		"category_name":   resource.CategoryName,
-		"category_id":     resource.CategoryId,
+		"category_name":   categoryName,
+		"category_id":     categoryID,
 		"info":            resource.Info,
 		"notes":           resource.Notes,
 		"os_requirements": resource.OSRequirements,
@@ -281,8 +297,8 @@ func ResourceJamfProScriptsUpdate(ctx context.Context, d *schema.ResourceData, m
 			resourceName := d.Get("name").(string)
 			_, apiErrByName := conn.UpdateScriptByName(resourceName, resource)
 			if apiErrByName != nil {
-				// If updating by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If updating by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully updated the resource, exit the retry loop
@@ -290,7 +306,7 @@ func ResourceJamfProScriptsUpdate(ctx context.Context, d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Script '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro Script '%s' (ID: %s)", d.Get("name").(string), resourceID))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -324,8 +340,8 @@ func ResourceJamfProScriptsDelete(ctx context.Context, d *schema.ResourceData, m
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteScriptByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -333,7 +349,7 @@ func ResourceJamfProScriptsDelete(ctx context.Context, d *schema.ResourceData, m
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Script '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro Script '%s' (ID: %s)", d.Get("name").(string), resourceID))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
@@ -0,0 +1,34 @@
+// sites_list_data_source.go
+package sites
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSitesList lists the Jamf Pro sites, optionally filtered by name.
+func DataSourceJamfProSitesList() *schema.Resource {
+	list := &crud.List{
+		Name:   "Jamf Pro Sites",
+		Lister: listSites,
+	}
+	return list.DataSource()
+}
+
+// listSites returns the ID and name of every Jamf Pro site.
+func listSites(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetSites()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.Site))
+	for _, site := range response.Site {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(site.ID), Name: site.Name})
+	}
+	return items, nil
+}
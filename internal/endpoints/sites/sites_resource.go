@@ -2,37 +2,76 @@
 package sites
 
 import (
-	"context"
-	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
-	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
-	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// site wires the Jamf Pro Site API calls into the shared CRUD implementation.
+var site = &crud.Resource[jamfpro.SharedResourceSite]{
+	Name:      "Jamf Pro Site",
+	Construct: constructJamfProSite,
+	State:     updateTerraformState,
+	Creator: func(conn *jamfpro.Client, resource *jamfpro.SharedResourceSite) (string, error) {
+		response, err := conn.CreateSite(resource)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(response.ID), nil
+	},
+	Getter: func(conn *jamfpro.Client, id string) (*jamfpro.SharedResourceSite, error) {
+		intID, err := crud.IntID(id)
+		if err != nil {
+			return nil, err
+		}
+		return conn.GetSiteByID(intID)
+	},
+	Updater: func(conn *jamfpro.Client, id string, resource *jamfpro.SharedResourceSite) error {
+		intID, err := crud.IntID(id)
+		if err != nil {
+			return err
+		}
+		_, err = conn.UpdateSiteByID(intID, resource)
+		return err
+	},
+	Deleter: func(conn *jamfpro.Client, id string) error {
+		intID, err := crud.IntID(id)
+		if err != nil {
+			return err
+		}
+		return conn.DeleteSiteByID(intID)
+	},
+	DeleterByName: func(conn *jamfpro.Client, name string) error {
+		return conn.DeleteSiteByName(name)
+	},
+	StabilizationTime: 10 * time.Second,
+}
+
 // ResourceJamfProSite defines the schema and CRUD operations for managing Jamf Pro Sites in Terraform.
 func ResourceJamfProSites() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: ResourceJamfProSitesCreate,
-		ReadContext:   ResourceJamfProSitesRead,
-		UpdateContext: ResourceJamfProSitesUpdate,
-		DeleteContext: ResourceJamfProSitesDelete,
+		CreateContext: site.Create,
+		ReadContext:   site.Read,
+		UpdateContext: site.Update,
+		DeleteContext: site.Delete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Second),
 			Read:   schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetSiteByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -48,111 +87,10 @@ func ResourceJamfProSites() *schema.Resource {
 	}
 }
 
-// ResourceJamfPrositesCreate is responsible for creating a new Jamf Pro Site in the remote system.
-// The function:
-// 1. Constructs the attribute data using the provided Terraform configuration.
-// 2. Calls the API to create the attribute in Jamf Pro.
-// 3. Updates the Terraform state with the ID of the newly created attribute.
-// 4. Initiates a read operation to synchronize the Terraform state with the actual state in Jamf Pro.
-func ResourceJamfProSitesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Assert the meta interface to the expected APIClient type
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
-	var diags diag.Diagnostics
-
-	// Construct the resource object
-	resource, err := constructJamfProSite(d)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Site: %v", err))
-	}
-
-	// Retry the API call to create the resource in Jamf Pro
-	var creationResponse *jamfpro.SharedResourceSite
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
-		var apiErr error
-		creationResponse, apiErr = conn.CreateSite(resource)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		// No error, exit the retry loop
-		return nil
-	})
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Site '%s' after retries: %v", resource.Name, err))
-	}
-
-	// Set the resource ID in Terraform state
-	d.SetId(strconv.Itoa(creationResponse.ID))
-
-	// Wait for the resource to be fully available before reading it
-	checkResourceExists := func(id interface{}) (interface{}, error) {
-		intID, err := strconv.Atoi(id.(string))
-		if err != nil {
-			return nil, fmt.Errorf("error converting ID '%v' to integer: %v", id, err)
-		}
-		return apiclient.Conn.GetSiteByID(intID)
-	}
-
-	_, waitDiags := waitfor.ResourceIsAvailable(ctx, d, "Jamf Pro Site", strconv.Itoa(creationResponse.ID), checkResourceExists, 10*time.Second)
-	if waitDiags.HasError() {
-		return waitDiags
-	}
-
-	// Read the resource to ensure the Terraform state is up to date
-	readDiags := ResourceJamfProSitesRead(ctx, d, meta)
-	if len(readDiags) > 0 {
-		diags = append(diags, readDiags...)
-	}
-
-	return diags
-}
-
-// ResourceJamfProSitesRead is responsible for reading the current state of a Jamf Pro Site Resource from the remote system.
-// The function:
-// 1. Fetches the attribute's current state using its ID. If it fails then obtain attribute's current state using its Name.
-// 2. Updates the Terraform state with the fetched data to ensure it accurately reflects the current state in Jamf Pro.
-// 3. Handles any discrepancies, such as the attribute being deleted outside of Terraform, to keep the Terraform state synchronized.
-func ResourceJamfProSitesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Initialize API client
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
+// updateTerraformState updates the Terraform state with the Jamf Pro Site returned by the API.
+func updateTerraformState(d *schema.ResourceData, resource *jamfpro.SharedResourceSite) diag.Diagnostics {
 	var diags diag.Diagnostics
-	resourceID := d.Id()
-	resourceIDInt, err := strconv.Atoi(resourceID)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error converting resource ID '%s' to int: %v", resourceID, err))
-	}
-
-	// Attempt to fetch the resource by ID
-	resource, err := conn.GetSiteByID(resourceIDInt)
-	if err != nil {
-		// If the error is a "not found" error, remove the resource from the state
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
-			d.SetId("") // Remove the resource from Terraform state
-			return diag.Diagnostics{
-				{
-					Severity: diag.Warning,
-					Summary:  "Resource not found",
-					Detail:   fmt.Sprintf("Jamf Pro Site with ID '%s' was not found and has been removed from the Terraform state.", resourceID),
-				},
-			}
-		}
-		// For other errors, return an error diagnostic
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Site with ID '%s': %v", resourceID, err))
-	}
 
-	// Update Terraform state with the resource information
 	if err := d.Set("id", strconv.Itoa(resource.ID)); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -160,100 +98,5 @@ func ResourceJamfProSitesRead(ctx context.Context, d *schema.ResourceData, meta
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	return nil
-}
-
-// ResourceJamfProSitesUpdate is responsible for updating an existing Jamf Pro Site on the remote system.
-func ResourceJamfProSitesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Initialize API client
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
-	var diags diag.Diagnostics
-	resourceID := d.Id()
-
-	// Convert resourceID from string to int
-	resourceIDInt, err := strconv.Atoi(resourceID)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error converting resource ID '%s' to int: %v", resourceID, err))
-	}
-
-	// Construct the resource object
-	resource, err := constructJamfProSite(d)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Site for update: %v", err))
-	}
-
-	// Update operations with retries
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, apiErr := conn.UpdateSiteByID(resourceIDInt, resource)
-		if apiErr != nil {
-			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
-		}
-		// Successfully updated the resource, exit the retry loop
-		return nil
-	})
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Site '%s' (ID: %d) after retries: %v", resource.Name, resourceIDInt, err))
-	}
-
-	// Read the resource to ensure the Terraform state is up to date
-	readDiags := ResourceJamfProSitesRead(ctx, d, meta)
-	if len(readDiags) > 0 {
-		diags = append(diags, readDiags...)
-	}
-
-	return diags
-}
-
-// ResourceJamfProSitesDelete is responsible for deleting a Jamf Pro Site.
-func ResourceJamfProSitesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Initialize API client
-	apiclient, ok := meta.(*client.APIClient)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.APIClient")
-	}
-	conn := apiclient.Conn
-
-	// Initialize variables
-	var diags diag.Diagnostics
-	resourceID := d.Id()
-
-	// Convert resourceID from string to int
-	resourceIDInt, err := strconv.Atoi(resourceID)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error converting resource ID '%s' to int: %v", resourceID, err))
-	}
-
-	// Use the retry function for the delete operation with appropriate timeout
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
-		// Attempt to delete by ID
-		apiErr := conn.DeleteSiteByID(resourceIDInt)
-		if apiErr != nil {
-			// If deleting by ID fails, attempt to delete by Name
-			resourceName := d.Get("name").(string)
-			apiErrByName := conn.DeleteSiteByName(resourceName)
-			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
-			}
-		}
-		// Successfully deleted the resource, exit the retry loop
-		return nil
-	})
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Site '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
-	}
-
-	// Clear the ID from the Terraform state as the resource has been deleted
-	d.SetId("")
-
 	return diags
 }
@@ -5,6 +5,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/criteria"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/defaults"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,6 +23,16 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interf
 		return err
 	}
 
+	// Validate the 'criteria' blocks of smart groups against the user criteria and extension attributes.
+	if err := criteria.CustomizeDiff(criteria.Users, isSmartGroup)(ctx, diff, i); err != nil {
+		return err
+	}
+
+	// Plan the provider's default site when 'site' is not configured.
+	if err := defaults.CustomizeDiff(defaults.Field{Key: "site", Kind: defaults.Site, Format: defaults.Block, Unset: []interface{}{}})(ctx, diff, i); err != nil {
+		return err
+	}
+
 	// Add more validation calls here as needed.
 
 	return nil
@@ -34,7 +47,8 @@ func validateIsSmartAttribute(_ context.Context, diff *schema.ResourceDiff, _ in
 		return nil
 	}
 
-	usersBlockExists := len(diff.Get("users").([]interface{})) > 0
+	// 'users' is also read from Jamf Pro, so only a block set in configuration counts
+	usersBlockExists := usersConfigured(diff.GetRawConfig())
 	criteriaBlockExists := len(diff.Get("criteria").([]interface{})) > 0
 
 	if isSmart.(bool) && usersBlockExists {
@@ -49,10 +63,6 @@ func validateIsSmartAttribute(_ context.Context, diff *schema.ResourceDiff, _ in
 		return fmt.Errorf("in 'jamfpro_user_group.%s': 'criteria' block is required when 'is_smart' is set to true", resourceName)
 	}
 
-	if !isSmart.(bool) && !usersBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'users' block is required when 'is_smart' is set to false", resourceName)
-	}
-
 	return nil
 }
 
@@ -74,3 +84,8 @@ func validateCriteriaPrioritySequence(_ context.Context, diff *schema.ResourceDi
 
 	return nil
 }
+
+// isSmartGroup reports whether the planned group is a smart group, whose criteria are validated.
+func isSmartGroup(diff *schema.ResourceDiff) bool {
+	return diff.Get("is_smart").(bool)
+}
@@ -0,0 +1,49 @@
+// usergroups_list_data_source.go
+package usergroups
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProUserGroupsList lists the Jamf Pro user groups, optionally filtered by name or site.
+func DataSourceJamfProUserGroupsList() *schema.Resource {
+	list := &crud.List{
+		Name:       "Jamf Pro User Groups",
+		Lister:     listUserGroups,
+		SiteGetter: getUserGroupSiteID,
+	}
+	return list.DataSource()
+}
+
+// listUserGroups returns the ID and name of every Jamf Pro user group.
+func listUserGroups(conn *jamfpro.Client) ([]crud.ListItem, error) {
+	response, err := conn.GetUserGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]crud.ListItem, 0, len(response.UserGroup))
+	for _, group := range response.UserGroup {
+		items = append(items, crud.ListItem{ID: strconv.Itoa(group.ID), Name: group.Name})
+	}
+	return items, nil
+}
+
+// getUserGroupSiteID returns the ID of the site a user group belongs to.
+func getUserGroupSiteID(conn *jamfpro.Client, id string) (string, error) {
+	intID, err := crud.IntID(id)
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := conn.GetUserGroupByID(intID)
+	if err != nil {
+		return "", err
+	}
+	return crud.SiteID(resource.Site), nil
+}
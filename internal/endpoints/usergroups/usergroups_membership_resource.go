@@ -0,0 +1,108 @@
+// usergroups_membership_resource.go
+package usergroups
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/membership"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriUserGroups = "/JSSResource/usergroups"
+
+// userGroupMembershipChange is a Classic API update of a static user group that only adds and
+// removes the given users, leaving its other members and settings unchanged.
+type userGroupMembershipChange struct {
+	XMLName       xml.Name                          `xml:"user_group"`
+	UserAdditions []jamfpro.UserGroupSubsetUserItem `xml:"user_additions>user,omitempty"`
+	UserDeletions []jamfpro.UserGroupSubsetUserItem `xml:"user_deletions>user,omitempty"`
+}
+
+// userGroupMembership manages the members listed in user_ids of a static user group.
+var userGroupMembership = &membership.Resource{
+	Name:    "Jamf Pro User Group",
+	Members: "users",
+	Key:     "user_ids",
+	Getter: func(conn *jamfpro.Client, id int) (*membership.Group, error) {
+		group, err := conn.GetUserGroupByID(id)
+		if err != nil {
+			return nil, err
+		}
+		memberIDs := make([]int, 0, len(group.Users))
+		for _, member := range group.Users {
+			memberIDs = append(memberIDs, member.ID)
+		}
+		return &membership.Group{Name: group.Name, IsSmart: group.IsSmart, MemberIDs: memberIDs}, nil
+	},
+	Updater: updateUserGroupMembers,
+}
+
+// ResourceJamfProUserGroupMembership defines the schema and CRUD operations for managing some of
+// the members of a static Jamf Pro user group in Terraform, without affecting its other members.
+func ResourceJamfProUserGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: userGroupMembership.Create,
+		ReadContext:   userGroupMembership.Read,
+		UpdateContext: userGroupMembership.Update,
+		DeleteContext: userGroupMembership.Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(30 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
+		Importer: userGroupMembership.Importer(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetUserGroupByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the user group whose members are managed.",
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be the numeric ID of a user group"),
+				Description:  "The ID of the static user group whose members are managed.",
+			},
+			"user_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the users this resource adds to the group. Only these users are added and removed; members added by other resources or in Jamf Pro are left in place.",
+			},
+		},
+	}
+}
+
+// updateUserGroupMembers adds users to and removes users from a static user group in a
+// single update.
+func updateUserGroupMembers(conn *jamfpro.Client, groupID string, add, remove []int) error {
+	change := userGroupMembershipChange{}
+	for _, id := range add {
+		change.UserAdditions = append(change.UserAdditions, jamfpro.UserGroupSubsetUserItem{ID: id})
+	}
+	for _, id := range remove {
+		change.UserDeletions = append(change.UserDeletions, jamfpro.UserGroupSubsetUserItem{ID: id})
+	}
+
+	var response jamfpro.ResourceUserGroup
+	resp, err := conn.HTTP.DoRequest("PUT", fmt.Sprintf("%s/id/%s", uriUserGroups, groupID), &change, &response)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -105,3 +106,47 @@ func extractUsers(usersInterface []interface{}) []jamfpro.UserGroupSubsetUserIte
 	}
 	return users
 }
+
+// usersConfigured reports whether the 'users' block is set in configuration, as opposed to
+// holding the users last read from Jamf Pro.
+func usersConfigured(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return true
+	}
+	users := rawConfig.GetAttr("users")
+	return !users.IsKnown() || (!users.IsNull() && users.LengthInt() > 0)
+}
+
+// userGroupSettings is a Classic API update of a user group that leaves its users unchanged, since
+// an update of a jamfpro.ResourceUserGroup always replaces them.
+type userGroupSettings struct {
+	XMLName          xml.Name                          `xml:"user_group"`
+	Name             string                            `xml:"name,omitempty"`
+	IsSmart          bool                              `xml:"is_smart"`
+	IsNotifyOnChange bool                              `xml:"is_notify_on_change"`
+	Site             jamfpro.SharedResourceSite        `xml:"site,omitempty"`
+	Criteria         []jamfpro.SharedSubsetCriteria    `xml:"criteria>criterion,omitempty"`
+	UserAdditions    []jamfpro.UserGroupSubsetUserItem `xml:"user_additions>user,omitempty"`
+	UserDeletions    []jamfpro.UserGroupSubsetUserItem `xml:"user_deletions>user,omitempty"`
+}
+
+// updateUserGroupSettings updates a static user group without changing its users, for when they
+// are not managed by its 'users' block.
+func updateUserGroupSettings(conn *jamfpro.Client, id int, userGroup *jamfpro.ResourceUserGroup) error {
+	settings := userGroupSettings{
+		Name:             userGroup.Name,
+		IsSmart:          userGroup.IsSmart,
+		IsNotifyOnChange: userGroup.IsNotifyOnChange,
+		Site:             userGroup.Site,
+		Criteria:         userGroup.Criteria,
+		UserAdditions:    userGroup.UserAdditions,
+		UserDeletions:    userGroup.UserDeletions,
+	}
+
+	var response jamfpro.ResponseUserGroupCreateAndUpdate
+	resp, err := conn.HTTP.DoRequest("PUT", fmt.Sprintf("%s/id/%d", uriUserGroups, id), &settings, &response)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
@@ -5,11 +5,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/common/crud"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/waitfor"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -45,9 +46,13 @@ func ResourceJamfProUserGroups() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: crud.ImportByIDOrName(func(conn *jamfpro.Client, name string) (string, error) {
+			resource, err := conn.GetUserGroupByName(name)
+			if err != nil {
+				return "", err
+			}
+			return strconv.Itoa(resource.ID), nil
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -72,6 +77,7 @@ func ResourceJamfProUserGroups() *schema.Resource {
 			"site": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -87,7 +93,7 @@ func ResourceJamfProUserGroups() *schema.Resource {
 						},
 					},
 				},
-				Description: "The site associated with the user group.",
+				Description: "The site associated with the user group. Defaults to the provider's 'default_site' when not set.",
 			},
 			"criteria": {
 				Type:        schema.TypeList,
@@ -148,7 +154,8 @@ func ResourceJamfProUserGroups() *schema.Resource {
 			"users": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "A block representing the users belonging to the user group.",
+				Computed:    true,
+				Description: "A block representing the users belonging to the user group. When it is not set, the users are not managed by this resource and can be managed with jamfpro_user_group_membership.",
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -240,14 +247,14 @@ func ResourceJamfProUserGroupCreate(ctx context.Context, d *schema.ResourceData,
 		var apiErr error
 		creationResponse, apiErr = conn.CreateUserGroup(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// No error, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro User Group '%s' after retries: %v", resource.Name, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to create Jamf Pro User Group '%s'", resource.Name))
 	}
 
 	// Set the resource ID in Terraform state
@@ -306,7 +313,7 @@ func ResourceJamfProUserGroupRead(ctx context.Context, d *schema.ResourceData, m
 		// Skip resource state removal if this is a create operation
 		if !d.IsNewResource() {
 			// If the error is a "not found" error, remove the resource from the state
-			if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410") {
+			if provider_diagnostics.IsNotFound(err) {
 				d.SetId("") // Remove the resource from Terraform state
 				return diag.Diagnostics{
 					{
@@ -318,7 +325,7 @@ func ResourceJamfProUserGroupRead(ctx context.Context, d *schema.ResourceData, m
 			}
 		}
 		// For other errors, or if this is a create operation, return a diagnostic error
-		return diag.FromErr(err)
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to read Jamf Pro User Group with ID '%s'", resourceID))
 	}
 
 	// Update the Terraform state with the fetched data
@@ -418,17 +425,23 @@ func ResourceJamfProUserGroupUpdate(ctx context.Context, d *schema.ResourceData,
 
 	// Update operations with retries
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, apiErr := conn.UpdateUserGroupByID(resourceIDInt, resource)
+		var apiErr error
+		if !resource.IsSmart && !usersConfigured(d.GetRawConfig()) {
+			// Leave the users, which may be managed by jamfpro_user_group_membership, unchanged
+			apiErr = updateUserGroupSettings(conn, resourceIDInt, resource)
+		} else {
+			_, apiErr = conn.UpdateUserGroupByID(resourceIDInt, resource)
+		}
 		if apiErr != nil {
 			// If updating by ID fails, attempt to update by Name
-			return retry.RetryableError(apiErr)
+			return provider_diagnostics.RetryError(apiErr)
 		}
 		// Successfully updated the resource, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro User Group '%s' (ID: %d) after retries: %v", resource.Name, resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to update Jamf Pro User Group '%s' (ID: %d)", resource.Name, resourceIDInt))
 	}
 
 	// Read the resource to ensure the Terraform state is up to date
@@ -468,8 +481,8 @@ func ResourceJamfProUserGroupDelete(ctx context.Context, d *schema.ResourceData,
 			resourceName := d.Get("name").(string)
 			apiErrByName := conn.DeleteUserGroupByName(resourceName)
 			if apiErrByName != nil {
-				// If deletion by name also fails, return a retryable error
-				return retry.RetryableError(apiErrByName)
+				// If deletion by name also fails, retry unless the failure is permanent
+				return provider_diagnostics.RetryError(apiErrByName)
 			}
 		}
 		// Successfully deleted the resource, exit the retry loop
@@ -477,7 +490,7 @@ func ResourceJamfProUserGroupDelete(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro User Group '%s' (ID: %d) after retries: %v", d.Get("name").(string), resourceIDInt, err))
+		return provider_diagnostics.GenerateTFDiagsFromHTTPError(err, d, fmt.Sprintf("failed to delete Jamf Pro User Group '%s' (ID: %d)", d.Get("name").(string), resourceIDInt))
 	}
 
 	// Clear the ID from the Terraform state as the resource has been deleted
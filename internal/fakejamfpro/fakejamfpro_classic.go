@@ -0,0 +1,379 @@
+// fakejamfpro_classic.go
+package fakejamfpro
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// errDuplicateName is returned when a Classic API object is created with a name already in use.
+var errDuplicateName = errors.New("Duplicate name")
+
+// xmlNode is a schemaless XML element, allowing the server to store any Classic API object.
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Content  string     `xml:",chardata"`
+	Children []*xmlNode `xml:",any"`
+}
+
+// classicCollection holds the objects stored under a single /JSSResource/{collection} path.
+type classicCollection struct {
+	root      string // element name of a single object, for example "policy" under "policies"
+	nextID    int
+	items     map[int]*xmlNode
+	singleton *xmlNode
+}
+
+// SeedClassic stores a Classic API object under collection (for example "policies") without
+// going through fault injection, returning its assigned id.
+func (s *Server) SeedClassic(collection, body string) (int, error) {
+	node, err := parseXMLNode([]byte(body))
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createClassic(collection, node)
+}
+
+// handleClassic serves /JSSResource/{collection}[/{key}/{value}[/subset/...]].
+func (s *Server) handleClassic(w http.ResponseWriter, r *http.Request) {
+	segments := splitEscapedPath(strings.TrimPrefix(r.URL.EscapedPath(), "/JSSResource/"))
+	if len(segments) == 0 || segments[0] == "" {
+		writeClassicError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	collection := segments[0]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(segments) == 1 {
+		s.handleClassicCollection(w, r, collection)
+		return
+	}
+	if len(segments) < 3 {
+		writeClassicError(w, http.StatusBadRequest, "Bad Request")
+		return
+	}
+
+	key, value := segments[1], segments[2]
+	if r.Method == http.MethodPost {
+		node, err := readXMLNode(r)
+		if err != nil {
+			writeClassicError(w, http.StatusBadRequest, fmt.Sprintf("Problem with request: %v", err))
+			return
+		}
+		id, err := s.createClassic(collection, node)
+		if err != nil {
+			writeClassicError(w, http.StatusConflict, fmt.Sprintf("Error: %v", err))
+			return
+		}
+		writeClassicID(w, http.StatusCreated, node.XMLName.Local, id)
+		return
+	}
+
+	coll := s.classic[collection]
+	id, node := coll.find(key, value)
+	if node == nil {
+		writeClassicError(w, http.StatusNotFound, "The server has not found anything matching the request URI")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeXML(w, http.StatusOK, node)
+	case http.MethodPut:
+		update, err := readXMLNode(r)
+		if err != nil {
+			writeClassicError(w, http.StatusBadRequest, fmt.Sprintf("Problem with request: %v", err))
+			return
+		}
+		applyGroupMembershipChanges(collection, node, update)
+		mergeXMLNode(node, update)
+		setClassicField(node, "id", strconv.Itoa(id))
+		writeClassicID(w, http.StatusCreated, coll.root, id)
+	case http.MethodDelete:
+		delete(coll.items, id)
+		writeClassicID(w, http.StatusOK, coll.root, id)
+	default:
+		writeClassicError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// handleClassicCollection lists a collection, or reads and replaces singleton resources such as
+// /JSSResource/computercheckin. Callers must hold s.mu.
+func (s *Server) handleClassicCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	coll := s.classicCollection(collection)
+
+	switch r.Method {
+	case http.MethodGet:
+		if coll.singleton != nil {
+			writeXML(w, http.StatusOK, coll.singleton)
+			return
+		}
+		writeXML(w, http.StatusOK, coll.list(collection))
+	case http.MethodPut:
+		update, err := readXMLNode(r)
+		if err != nil {
+			writeClassicError(w, http.StatusBadRequest, fmt.Sprintf("Problem with request: %v", err))
+			return
+		}
+		if coll.singleton == nil {
+			coll.singleton = update
+		} else {
+			mergeXMLNode(coll.singleton, update)
+		}
+		writeXML(w, http.StatusCreated, coll.singleton)
+	default:
+		writeClassicError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// classicCollection returns the named collection, creating it on first use. Callers must hold s.mu.
+func (s *Server) classicCollection(name string) *classicCollection {
+	coll, ok := s.classic[name]
+	if !ok {
+		coll = &classicCollection{items: make(map[int]*xmlNode)}
+		s.classic[name] = coll
+	}
+	return coll
+}
+
+// createClassic assigns an id to node and stores it. Callers must hold s.mu.
+func (s *Server) createClassic(collection string, node *xmlNode) (int, error) {
+	coll := s.classicCollection(collection)
+	if name := classicField(node, "name"); name != "" {
+		if _, existing := coll.find("name", name); existing != nil {
+			return 0, errDuplicateName
+		}
+	}
+	if coll.root == "" {
+		coll.root = node.XMLName.Local
+	}
+
+	coll.nextID++
+	id := coll.nextID
+	setClassicField(node, "id", strconv.Itoa(id))
+	coll.items[id] = node
+
+	s.objectCreated("/JSSResource/"+collection, fmt.Sprintf("/JSSResource/%s/id/%d", collection, id))
+	return id, nil
+}
+
+// find looks an object up by id, name or any other field name used in Classic API paths, such
+// as serialnumber or udid.
+func (c *classicCollection) find(key, value string) (int, *xmlNode) {
+	if c == nil {
+		return 0, nil
+	}
+	if key == "id" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, nil
+		}
+		return id, c.items[id]
+	}
+	for id, node := range c.items {
+		if classicField(node, key) == value {
+			return id, node
+		}
+	}
+	return 0, nil
+}
+
+// list renders the collection in the Classic API list format of a size element followed by
+// the id and name of each object. Like Jamf Pro, the list element of a collection such as
+// "userextensionattributes" keeps the underscores of its objects, as in "user_extension_attributes".
+func (c *classicCollection) list(collection string) *xmlNode {
+	ids := make([]int, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	name := collection
+	if plural := c.root + "s"; strings.ReplaceAll(plural, "_", "") == collection {
+		name = plural
+	}
+	list := &xmlNode{XMLName: xml.Name{Local: name}}
+	list.Children = append(list.Children, textNode("size", strconv.Itoa(len(ids))))
+	for _, id := range ids {
+		list.Children = append(list.Children, &xmlNode{
+			XMLName: xml.Name{Local: c.root},
+			Children: []*xmlNode{
+				textNode("id", strconv.Itoa(id)),
+				textNode("name", classicField(c.items[id], "name")),
+			},
+		})
+	}
+	return list
+}
+
+// classicField returns the value of a top level field, falling back to the general block used by
+// objects such as policies and computers. Field names are matched ignoring case and underscores.
+func classicField(node *xmlNode, name string) string {
+	if child := node.child(name); child != nil {
+		return child.Content
+	}
+	if general := node.child("general"); general != nil {
+		if child := general.child(name); child != nil {
+			return child.Content
+		}
+	}
+	return ""
+}
+
+// setClassicField sets a field in the location classicField reads it from, adding it to the
+// general block when the object has one.
+func setClassicField(node *xmlNode, name, value string) {
+	target := node
+	if node.child(name) == nil {
+		if general := node.child("general"); general != nil {
+			target = general
+		}
+	}
+	if child := target.child(name); child != nil {
+		child.Content = value
+		return
+	}
+	target.Children = append([]*xmlNode{textNode(name, value)}, target.Children...)
+}
+
+// child returns the first child element matching name, ignoring case and underscores.
+func (n *xmlNode) child(name string) *xmlNode {
+	want := normaliseFieldName(name)
+	for _, c := range n.Children {
+		if normaliseFieldName(c.XMLName.Local) == want {
+			return c
+		}
+	}
+	return nil
+}
+
+// mergeXMLNode replaces the top level elements of dst with those present in src, matching the
+// partial update semantics of the Classic API.
+func mergeXMLNode(dst, src *xmlNode) {
+	replaced := make(map[string]bool)
+	for _, c := range src.Children {
+		replaced[c.XMLName.Local] = true
+	}
+
+	merged := make([]*xmlNode, 0, len(dst.Children)+len(src.Children))
+	inserted := make(map[string]bool)
+	for _, c := range dst.Children {
+		name := c.XMLName.Local
+		if !replaced[name] {
+			merged = append(merged, c)
+			continue
+		}
+		if inserted[name] {
+			continue
+		}
+		inserted[name] = true
+		for _, sc := range src.Children {
+			if sc.XMLName.Local == name {
+				merged = append(merged, sc)
+			}
+		}
+	}
+	for _, sc := range src.Children {
+		if !inserted[sc.XMLName.Local] {
+			merged = append(merged, sc)
+		}
+	}
+	dst.Children = merged
+}
+
+// readXMLNode parses the request body into an xmlNode.
+func readXMLNode(r *http.Request) (*xmlNode, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseXMLNode(body)
+}
+
+// parseXMLNode parses a document into an xmlNode, dropping formatting whitespace.
+func parseXMLNode(body []byte) (*xmlNode, error) {
+	var node xmlNode
+	if err := xml.Unmarshal(body, &node); err != nil {
+		return nil, err
+	}
+	node.trim()
+	return &node, nil
+}
+
+// trim removes the indentation whitespace captured as character data of non leaf elements.
+func (n *xmlNode) trim() {
+	if len(n.Children) == 0 {
+		return
+	}
+	n.Content = strings.TrimSpace(n.Content)
+	for _, c := range n.Children {
+		c.trim()
+	}
+}
+
+// textNode returns a leaf element holding value.
+func textNode(name, value string) *xmlNode {
+	return &xmlNode{XMLName: xml.Name{Local: name}, Content: value}
+}
+
+// normaliseFieldName lower cases name and strips underscores so that path keys such as
+// "serialnumber" match elements such as "serial_number".
+func normaliseFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// splitEscapedPath splits an escaped URL path into unescaped segments.
+func splitEscapedPath(p string) []string {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = unescaped
+		}
+	}
+	return segments
+}
+
+// writeXML writes node as a Classic API response.
+func writeXML(w http.ResponseWriter, status int, node *xmlNode) {
+	body, err := xml.Marshal(node)
+	if err != nil {
+		writeClassicError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, xml.Header)
+	_, _ = w.Write(body)
+}
+
+// writeClassicID writes the <root><id>N</id></root> body returned by Classic API writes.
+func writeClassicID(w http.ResponseWriter, status int, root string, id int) {
+	writeXML(w, status, &xmlNode{
+		XMLName:  xml.Name{Local: root},
+		Children: []*xmlNode{textNode("id", strconv.Itoa(id))},
+	})
+}
+
+// writeClassicError writes the HTML status page the Classic API returns for failed requests.
+func writeClassicError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html;charset=UTF-8")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<html><head><title>Status page</title></head><body style="font-family: sans-serif;">`+
+		`<p style="font-size: 1.2em;font-weight: bold;margin: 1em 0px;">%s</p><p>%s</p></body></html>`,
+		html.EscapeString(http.StatusText(status)), html.EscapeString(message))
+}
@@ -0,0 +1,91 @@
+// fakejamfpro_faults.go
+package fakejamfpro
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// Fault describes a canned failure the server applies to matching requests in place of, or in
+// addition to, the normal response.
+type Fault struct {
+	Method string        // HTTP method to match, any method when empty.
+	Path   string        // path.Match pattern for the request path, any path when empty.
+	Status int           // Status code to return. The normal response is served when zero.
+	Delay  time.Duration // Time to wait before responding.
+	Times  int           // Number of matching requests the fault applies to, unlimited when zero.
+
+	hits int
+}
+
+// AddFault registers a fault. Faults are evaluated in the order they were added and the first
+// match wins.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every registered fault and pending not found window.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+	s.notFoundOnCreate = make(map[string]int)
+}
+
+// NotFoundAfterCreate makes the next object created under collectionPath (for example
+// "/JSSResource/policies" or "/api/v1/buildings") answer 404 to its first gets GET requests,
+// mimicking the replication delay seen on real tenants.
+func (s *Server) NotFoundAfterCreate(collectionPath string, gets int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.notFoundOnCreate[strings.TrimSuffix(collectionPath, "/")] = gets
+}
+
+// matchFault returns the first fault matching the request and records the hit. Callers must
+// hold s.mu.
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && !strings.EqualFold(f.Method, r.Method) {
+			continue
+		}
+		if f.Path != "" {
+			if ok, _ := path.Match(f.Path, r.URL.Path); !ok {
+				continue
+			}
+		}
+
+		f.hits++
+		if f.Times > 0 && f.hits >= f.Times {
+			s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+		}
+		return f
+	}
+	return nil
+}
+
+// objectCreated arms any pending not found window for the collection an object was created in.
+// Callers must hold s.mu.
+func (s *Server) objectCreated(collectionPath, objectPath string) {
+	gets, ok := s.notFoundOnCreate[collectionPath]
+	if !ok {
+		return
+	}
+	delete(s.notFoundOnCreate, collectionPath)
+	if gets <= 0 {
+		return
+	}
+
+	s.faults = append([]*Fault{{
+		Method: http.MethodGet,
+		Path:   objectPath,
+		Status: http.StatusNotFound,
+		Times:  gets,
+	}}, s.faults...)
+}
@@ -0,0 +1,73 @@
+// fakejamfpro_groups.go
+package fakejamfpro
+
+// groupMembership names the elements a static group collection lists its members in and accepts
+// incremental membership changes in.
+type groupMembership struct {
+	members   string
+	member    string
+	additions string
+	deletions string
+}
+
+// groupMemberships are the Classic API collections whose updates accept member additions and deletions.
+var groupMemberships = map[string]groupMembership{
+	"computergroups": {members: "computers", member: "computer", additions: "computer_additions", deletions: "computer_deletions"},
+	"usergroups":     {members: "users", member: "user", additions: "user_additions", deletions: "user_deletions"},
+}
+
+// applyGroupMembershipChanges applies the member additions and deletions of an update to a static
+// group to the group's members and removes them from the update, as Jamf Pro does not store them.
+// Members are matched by id. Updates to other collections are left unchanged.
+func applyGroupMembershipChanges(collection string, group, update *xmlNode) {
+	membership, ok := groupMemberships[collection]
+	if !ok {
+		return
+	}
+	additions, deletions := update.child(membership.additions), update.child(membership.deletions)
+	if additions == nil && deletions == nil {
+		return
+	}
+
+	members := group.child(membership.members)
+	if members == nil {
+		members = &xmlNode{}
+		members.XMLName.Local = membership.members
+		group.Children = append(group.Children, members)
+	}
+
+	deleted := map[string]bool{}
+	if deletions != nil {
+		for _, member := range deletions.Children {
+			deleted[classicField(member, "id")] = true
+		}
+	}
+	present := map[string]bool{}
+	kept := members.Children[:0]
+	for _, member := range members.Children {
+		id := classicField(member, "id")
+		if !deleted[id] {
+			kept = append(kept, member)
+			present[id] = true
+		}
+	}
+	members.Children = kept
+
+	if additions != nil {
+		for _, member := range additions.Children {
+			if id := classicField(member, "id"); !present[id] {
+				member.XMLName.Local = membership.member
+				members.Children = append(members.Children, member)
+				present[id] = true
+			}
+		}
+	}
+
+	var remaining []*xmlNode
+	for _, c := range update.Children {
+		if c != additions && c != deletions {
+			remaining = append(remaining, c)
+		}
+	}
+	update.Children = remaining
+}
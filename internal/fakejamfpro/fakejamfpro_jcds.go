@@ -0,0 +1,289 @@
+// fakejamfpro_jcds.go
+package fakejamfpro
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// jcdsBucket is the S3 bucket named in issued JCDS upload credentials. Storage requests are
+	// served path-style under /{jcdsBucket}/.
+	jcdsBucket = "jcds-fake"
+	// jcdsPath is the key prefix named in issued JCDS upload credentials.
+	jcdsPath = "fake-tenant/data/"
+	// jcdsRegion is the AWS region named in issued JCDS upload credentials.
+	jcdsRegion = "us-east-1"
+)
+
+// multipartUpload tracks the parts received for an S3 multipart upload.
+type multipartUpload struct {
+	key   string
+	parts map[int][]byte
+}
+
+// JCDSFile returns the contents of a file uploaded to the fake JCDS by name.
+func (s *Server) JCDSFile(name string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, ok := s.objects[jcdsPath+name]
+	return data, ok
+}
+
+// handleJCDS serves the /api/v1/jcds endpoints.
+func (s *Server) handleJCDS(w http.ResponseWriter, r *http.Request) {
+	endpoint := strings.TrimPrefix(r.URL.Path, "/api/v1/jcds/")
+
+	switch {
+	case endpoint == "files" && r.Method == http.MethodPost, endpoint == "renew-credentials" && r.Method == http.MethodPost:
+		writeJSON(w, http.StatusOK, map[string]string{
+			"accessKeyID":     "FAKEACCESSKEYID",
+			"secretAccessKey": "fake-secret-access-key",
+			"sessionToken":    "fake-session-token",
+			"region":          jcdsRegion,
+			"bucketName":      jcdsBucket,
+			"path":            jcdsPath,
+			"uuid":            randomHex(16),
+		})
+	case endpoint == "files" && r.Method == http.MethodGet:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		files := make([]map[string]interface{}, 0, len(s.objects))
+		for key, data := range s.objects {
+			sum := md5.Sum(data)
+			files = append(files, map[string]interface{}{
+				"fileName": strings.TrimPrefix(key, jcdsPath),
+				"length":   len(data),
+				"md5":      hex.EncodeToString(sum[:]),
+				"region":   jcdsRegion,
+				"sha3":     "",
+			})
+		}
+		sort.Slice(files, func(i, j int) bool { return files[i]["fileName"].(string) < files[j]["fileName"].(string) })
+		writeJSON(w, http.StatusOK, files)
+	case endpoint == "properties" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"jcds2Enabled":              true,
+			"fileStreamEndpointEnabled": true,
+			"maxChunkSize":              5 * 1024 * 1024,
+		})
+	case strings.HasPrefix(endpoint, "files/"):
+		name := strings.TrimPrefix(endpoint, "files/")
+		key := jcdsPath + name
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.objects[key]; !ok {
+			writeProAPIError(w, http.StatusNotFound, fmt.Sprintf("file %s could not be found", name))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]string{"uri": fmt.Sprintf("%s/%s/%s", s.URL, jcdsBucket, key)})
+		case http.MethodDelete:
+			delete(s.objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeProAPIError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		}
+	default:
+		writeProAPIError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+// handleStorage serves the subset of the S3 API used by the AWS SDK upload manager: object
+// PUT, GET, HEAD and DELETE plus the multipart upload calls.
+func (s *Server) handleStorage(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/"+jcdsBucket+"/")
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID = randomHex(16)
+		s.uploads[uploadID] = &multipartUpload{key: key, parts: make(map[int][]byte)}
+		writeStorageXML(w, http.StatusOK, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: jcdsBucket, Key: key, UploadId: uploadID})
+	case r.Method == http.MethodPut && uploadID != "":
+		upload, ok := s.uploads[uploadID]
+		if !ok {
+			writeStorageError(w, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist.")
+			return
+		}
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			writeStorageError(w, http.StatusBadRequest, "InvalidArgument", "Part number must be an integer.")
+			return
+		}
+		data, err := readStorageBody(r)
+		if err != nil {
+			writeStorageError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		upload.parts[partNumber] = data
+		w.Header().Set("ETag", etag(data))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && uploadID != "":
+		upload, ok := s.uploads[uploadID]
+		if !ok {
+			writeStorageError(w, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist.")
+			return
+		}
+		type part struct {
+			PartNumber int
+			ETag       string
+			Size       int
+		}
+		result := struct {
+			XMLName  xml.Name `xml:"ListPartsResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+			Part     []part
+		}{Bucket: jcdsBucket, Key: upload.key, UploadId: uploadID}
+		for _, n := range upload.partNumbers() {
+			result.Part = append(result.Part, part{PartNumber: n, ETag: etag(upload.parts[n]), Size: len(upload.parts[n])})
+		}
+		writeStorageXML(w, http.StatusOK, result)
+	case r.Method == http.MethodPost && uploadID != "":
+		upload, ok := s.uploads[uploadID]
+		if !ok {
+			writeStorageError(w, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist.")
+			return
+		}
+		var data []byte
+		for _, n := range upload.partNumbers() {
+			data = append(data, upload.parts[n]...)
+		}
+		delete(s.uploads, uploadID)
+		s.objects[key] = data
+		writeStorageXML(w, http.StatusOK, struct {
+			XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+			Location string
+			Bucket   string
+			Key      string
+			ETag     string
+		}{Location: fmt.Sprintf("%s/%s/%s", s.URL, jcdsBucket, key), Bucket: jcdsBucket, Key: key, ETag: etag(data)})
+	case r.Method == http.MethodDelete && uploadID != "":
+		delete(s.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		data, err := readStorageBody(r)
+		if err != nil {
+			writeStorageError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		s.objects[key] = data
+		w.Header().Set("ETag", etag(data))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet, r.Method == http.MethodHead:
+		data, ok := s.objects[key]
+		if !ok {
+			writeStorageError(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+		w.Header().Set("ETag", etag(data))
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeStorageError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+	}
+}
+
+// partNumbers returns the received part numbers in ascending order.
+func (u *multipartUpload) partNumbers() []int {
+	numbers := make([]int, 0, len(u.parts))
+	for n := range u.parts {
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+	return numbers
+}
+
+// readStorageBody reads an object body, decoding the aws-chunked framing used for streaming
+// signed payloads.
+func readStorageBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var data bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.TrimSpace(strings.SplitN(header, ";", 2)[0]), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid aws-chunked header %q", header)
+		}
+		if size == 0 {
+			return data.Bytes(), nil
+		}
+		if _, err := io.CopyN(&data, reader, size); err != nil {
+			return nil, err
+		}
+		if _, err := reader.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// etag returns the quoted MD5 entity tag S3 reports for data.
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return strconv.Quote(hex.EncodeToString(sum[:]))
+}
+
+// writeStorageXML writes v as an S3 XML response.
+func writeStorageXML(w http.ResponseWriter, status int, v interface{}) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		writeStorageError(w, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, xml.Header)
+	_, _ = w.Write(body)
+}
+
+// writeStorageError writes an S3 error document.
+func writeStorageError(w http.ResponseWriter, status int, code, message string) {
+	body, _ := xml.Marshal(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: message})
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, xml.Header)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,48 @@
+// fakejamfpro_mobiledevices.go
+package fakejamfpro
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// handleMobileDeviceDetail serves the Jamf Pro API v2 mobile device inventory, mobile-devices/detail
+// and mobile-devices/{id}/detail, from the objects stored in the mobile-devices collection. The
+// inventory identifies each device by mobileDeviceId, and every section is returned whatever
+// sections are requested. Callers must hold s.mu.
+func (s *Server) handleMobileDeviceDetail(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		writeProAPIError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+
+	coll := s.proAPICollection("mobile-devices")
+	if id != "" {
+		object := coll.get(id)
+		if object == nil {
+			writeProAPIError(w, http.StatusNotFound, fmt.Sprintf("Mobile device with id %s could not be found", id))
+			return
+		}
+		writeJSON(w, http.StatusOK, mobileDeviceDetail(id, object))
+		return
+	}
+
+	page := coll.page(r)
+	results := page["results"].([]interface{})
+	for i, result := range results {
+		object := result.(map[string]interface{})
+		results[i] = mobileDeviceDetail(fmt.Sprint(object["id"]), object)
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+// mobileDeviceDetail returns a copy of a stored mobile device in the inventory detail format.
+func mobileDeviceDetail(id string, object map[string]interface{}) map[string]interface{} {
+	detail := make(map[string]interface{}, len(object))
+	for k, v := range object {
+		detail[k] = v
+	}
+	delete(detail, "id")
+	detail["mobileDeviceId"] = id
+	return detail
+}
@@ -0,0 +1,97 @@
+// fakejamfpro_prestages.go
+package fakejamfpro
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// prestageCollections lists the Jamf Pro API collections whose scope sub-resource is served with
+// the add-multiple, delete-multiple and replace semantics of the prestage scope endpoints.
+var prestageCollections = map[string]bool{
+	"computer-prestages":      true,
+	"mobile-device-prestages": true,
+}
+
+// prestageScopeUpdate is the request body of the prestage scope endpoints.
+type prestageScopeUpdate struct {
+	SerialNumbers []string `json:"serialNumbers"`
+	VersionLock   *float64 `json:"versionLock"`
+}
+
+// handlePrestageScope serves {prestages}/{id}/scope, {prestages}/{id}/scope/add-multiple and
+// {prestages}/{id}/scope/delete-multiple. The scope exists, empty, as soon as the prestage does,
+// and every change must carry the scope's current version lock. Callers must hold s.mu.
+func (s *Server) handlePrestageScope(w http.ResponseWriter, r *http.Request, collection, id string, action string) {
+	key := collection + "/" + id + "/scope"
+	scope := s.singletons[key]
+	if scope == nil {
+		scope = map[string]interface{}{"prestageId": id, "assignments": []interface{}{}, "versionLock": float64(0)}
+	}
+
+	if r.Method == http.MethodGet && action == "" {
+		writeJSON(w, http.StatusOK, scope)
+		return
+	}
+
+	allowed := (action == "" && r.Method == http.MethodPut) ||
+		((action == "add-multiple" || action == "delete-multiple") && r.Method == http.MethodPost)
+	if !allowed {
+		writeProAPIError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+
+	var update prestageScopeUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		writeProAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	lock, _ := scope["versionLock"].(float64)
+	if update.VersionLock == nil || *update.VersionLock != lock {
+		writeProAPIErrorCode(w, http.StatusConflict, "OPTIMISTIC_LOCK_FAILED", fmt.Sprintf("Optimistic lock failed: expected versionLock %v", lock))
+		return
+	}
+
+	assigned := make(map[string]map[string]interface{})
+	if action != "" {
+		assignments, _ := scope["assignments"].([]interface{})
+		for _, item := range assignments {
+			if assignment, ok := item.(map[string]interface{}); ok {
+				serial, _ := assignment["serialNumber"].(string)
+				assigned[serial] = assignment
+			}
+		}
+	}
+	for _, serial := range update.SerialNumbers {
+		serial = strings.ToUpper(serial)
+		if action == "delete-multiple" {
+			delete(assigned, serial)
+			continue
+		}
+		if _, ok := assigned[serial]; !ok {
+			assigned[serial] = map[string]interface{}{
+				"serialNumber":   serial,
+				"assignmentDate": time.Now().UTC().Format(time.RFC3339),
+				"userAssigned":   DefaultUsername,
+			}
+		}
+	}
+
+	serials := make([]string, 0, len(assigned))
+	for serial := range assigned {
+		serials = append(serials, serial)
+	}
+	sort.Strings(serials)
+	assignments := make([]interface{}, 0, len(serials))
+	for _, serial := range serials {
+		assignments = append(assignments, assigned[serial])
+	}
+
+	scope = map[string]interface{}{"prestageId": id, "assignments": assignments, "versionLock": lock + 1}
+	s.singletons[key] = scope
+	writeJSON(w, http.StatusOK, scope)
+}
@@ -0,0 +1,364 @@
+// fakejamfpro_proapi.go
+package fakejamfpro
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// numericIDCollections lists the Jamf Pro API collections whose objects use a JSON number,
+// rather than a string, for their id.
+var numericIDCollections = map[string]bool{
+	"api-integrations": true,
+}
+
+// proAPICollection holds the objects stored under a single /api/vN/{collection} path. Objects
+// are kept as decoded JSON so the server does not need to know their schema.
+type proAPICollection struct {
+	nextID int
+	items  map[string]map[string]interface{}
+}
+
+// SeedProAPI stores a Jamf Pro API object under collection (for example "buildings") without
+// going through fault injection, returning its assigned id.
+func (s *Server) SeedProAPI(collection string, object map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createProAPI("", collection, object)
+}
+
+// SetProAPISingleton stores the document served by a singleton endpoint such as
+// "jamf-pro-version" or "computer-inventory-collection-settings".
+func (s *Server) SetProAPISingleton(path string, object map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.singletons[strings.Trim(path, "/")] = object
+}
+
+// handleProAPI serves /api/vN/{collection}[/{id}[/{subresource}...]]. Sub-resources are stored as
+// documents attached to their parent object, except prestage scopes, which handlePrestageScope serves,
+// and the mobile device inventory, which handleMobileDeviceDetail serves.
+func (s *Server) handleProAPI(w http.ResponseWriter, r *http.Request) {
+	segments := splitEscapedPath(strings.TrimPrefix(r.URL.EscapedPath(), "/api/"))
+	if len(segments) < 2 || !isAPIVersion(segments[0]) {
+		writeProAPIError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	version, segments := segments[0], segments[1:]
+	collection := segments[0]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if collection == "mobile-devices" && segments[len(segments)-1] == "detail" && len(segments) <= 3 {
+		id := ""
+		if len(segments) == 3 {
+			id = segments[1]
+		}
+		s.handleMobileDeviceDetail(w, r, id)
+		return
+	}
+
+	if len(segments) == 1 {
+		s.handleProAPICollection(w, r, version, collection)
+		return
+	}
+
+	coll := s.proAPI[collection]
+	id := segments[1]
+	if id == "delete-multiple" && r.Method == http.MethodPost {
+		s.handleProAPIDeleteMultiple(w, r, coll)
+		return
+	}
+
+	object := coll.get(id)
+	if object == nil {
+		writeProAPIError(w, http.StatusNotFound, fmt.Sprintf("%s with id %s could not be found", collection, id))
+		return
+	}
+
+	if len(segments) > 2 && segments[2] == "scope" && prestageCollections[collection] {
+		s.handlePrestageScope(w, r, collection, id, strings.Join(segments[3:], "/"))
+		return
+	}
+	if len(segments) > 2 {
+		s.handleProAPIDocument(w, r, strings.Join(segments, "/"))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, object)
+	case http.MethodPut, http.MethodPatch:
+		var update map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			writeProAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := checkVersionLocks(object, update); err != nil {
+			writeProAPIErrorCode(w, http.StatusConflict, "OPTIMISTIC_LOCK_FAILED", err.Error())
+			return
+		}
+		if r.Method == http.MethodPut {
+			for k := range object {
+				delete(object, k)
+			}
+		}
+		for k, v := range update {
+			object[k] = v
+		}
+		object["id"] = coll.idValue(collection, id)
+		bumpVersionLocks(object)
+		writeJSON(w, http.StatusOK, object)
+	case http.MethodDelete:
+		delete(coll.items, id)
+		for key := range s.singletons {
+			if strings.HasPrefix(key, collection+"/"+id+"/") {
+				delete(s.singletons, key)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeProAPIError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// handleProAPICollection lists or creates objects, or serves a singleton endpoint when one has
+// been stored at the collection path. Callers must hold s.mu.
+func (s *Server) handleProAPICollection(w http.ResponseWriter, r *http.Request, version, collection string) {
+	if _, ok := s.singletons[collection]; ok || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		s.handleProAPIDocument(w, r, collection)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.proAPICollection(collection).page(r))
+	case http.MethodPost:
+		var object map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&object); err != nil {
+			writeProAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		id := s.createProAPI(version, collection, object)
+		response := make(map[string]interface{}, len(object)+1)
+		for k, v := range object {
+			response[k] = v
+		}
+		response["href"] = fmt.Sprintf("%s/api/%s/%s/%s", s.URL, version, collection, id)
+		writeJSON(w, http.StatusCreated, response)
+	default:
+		writeProAPIError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// handleProAPIDocument reads or replaces a document stored outside of a collection, such as a
+// settings endpoint or an object's sub-resource. Callers must hold s.mu.
+func (s *Server) handleProAPIDocument(w http.ResponseWriter, r *http.Request, key string) {
+	document := s.singletons[key]
+
+	switch r.Method {
+	case http.MethodGet:
+		if document == nil {
+			writeProAPIError(w, http.StatusNotFound, fmt.Sprintf("%s could not be found", key))
+			return
+		}
+		writeJSON(w, http.StatusOK, document)
+	case http.MethodPut, http.MethodPatch, http.MethodPost:
+		var update map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			writeProAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if document == nil || r.Method != http.MethodPatch {
+			if err := checkVersionLocks(document, update); err != nil {
+				writeProAPIErrorCode(w, http.StatusConflict, "OPTIMISTIC_LOCK_FAILED", err.Error())
+				return
+			}
+			document = update
+		} else {
+			for k, v := range update {
+				document[k] = v
+			}
+		}
+		bumpVersionLocks(document)
+		s.singletons[key] = document
+		writeJSON(w, http.StatusOK, document)
+	case http.MethodDelete:
+		delete(s.singletons, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeProAPIError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// handleProAPIDeleteMultiple serves the POST {collection}/delete-multiple endpoint. Callers must
+// hold s.mu.
+func (s *Server) handleProAPIDeleteMultiple(w http.ResponseWriter, r *http.Request, coll *proAPICollection) {
+	var request struct {
+		IDs []string `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeProAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if coll != nil {
+		for _, id := range request.IDs {
+			delete(coll.items, id)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// proAPICollection returns the named collection, creating it on first use. Callers must hold s.mu.
+func (s *Server) proAPICollection(name string) *proAPICollection {
+	coll, ok := s.proAPI[name]
+	if !ok {
+		coll = &proAPICollection{items: make(map[string]map[string]interface{})}
+		s.proAPI[name] = coll
+	}
+	return coll
+}
+
+// createProAPI assigns an id to object, initialises any version locks and stores it. Callers
+// must hold s.mu.
+func (s *Server) createProAPI(version, collection string, object map[string]interface{}) string {
+	coll := s.proAPICollection(collection)
+	coll.nextID++
+	id := strconv.Itoa(coll.nextID)
+
+	object["id"] = coll.idValue(collection, id)
+	resetVersionLocks(object)
+	coll.items[id] = object
+
+	if version != "" {
+		base := fmt.Sprintf("/api/%s/%s", version, collection)
+		s.objectCreated(base, base+"/"+id)
+	}
+	return id
+}
+
+// get returns the object stored under id, or nil.
+func (c *proAPICollection) get(id string) map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return c.items[id]
+}
+
+// idValue returns id in the JSON type the collection uses for identifiers.
+func (c *proAPICollection) idValue(collection, id string) interface{} {
+	if numericIDCollections[collection] {
+		n, _ := strconv.Atoi(id)
+		return n
+	}
+	return id
+}
+
+// page renders the collection in the Jamf Pro API paged list format, honouring the page and
+// page-size query parameters.
+func (c *proAPICollection) page(r *http.Request) map[string]interface{} {
+	ids := make([]int, 0, len(c.items))
+	for id := range c.items {
+		n, _ := strconv.Atoi(id)
+		ids = append(ids, n)
+	}
+	sort.Ints(ids)
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	size, err := strconv.Atoi(r.URL.Query().Get("page-size"))
+	if err != nil || size <= 0 {
+		size = 100
+	}
+
+	results := make([]interface{}, 0, size)
+	for i := page * size; i >= 0 && i < len(ids) && i < (page+1)*size; i++ {
+		results = append(results, c.items[strconv.Itoa(ids[i])])
+	}
+	return map[string]interface{}{"totalCount": len(ids), "results": results}
+}
+
+// checkVersionLocks verifies that every versionLock in current, including those of nested
+// objects, is matched by update, mirroring Jamf Pro's optimistic locking.
+func checkVersionLocks(current, update map[string]interface{}) error {
+	for k, v := range current {
+		switch value := v.(type) {
+		case float64:
+			if k != "versionLock" {
+				continue
+			}
+			if got, ok := update[k].(float64); !ok || got != value {
+				return fmt.Errorf("Optimistic lock failed: expected versionLock %v", value)
+			}
+		case map[string]interface{}:
+			if nested, ok := update[k].(map[string]interface{}); ok {
+				if err := checkVersionLocks(value, nested); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resetVersionLocks sets every versionLock in object, including nested ones, to zero.
+func resetVersionLocks(object map[string]interface{}) {
+	for k, v := range object {
+		if k == "versionLock" {
+			object[k] = float64(0)
+		} else if nested, ok := v.(map[string]interface{}); ok {
+			resetVersionLocks(nested)
+		}
+	}
+}
+
+// bumpVersionLocks increments every versionLock in object, including nested ones.
+func bumpVersionLocks(object map[string]interface{}) {
+	for k, v := range object {
+		if lock, ok := v.(float64); ok && k == "versionLock" {
+			object[k] = lock + 1
+		} else if nested, ok := v.(map[string]interface{}); ok {
+			bumpVersionLocks(nested)
+		}
+	}
+}
+
+// isAPIVersion reports whether segment is a version prefix such as "v1".
+func isAPIVersion(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(segment[1:])
+	return err == nil
+}
+
+// writeJSON writes v as a Jamf Pro API response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeProAPIError writes a Jamf Pro API error body.
+func writeProAPIError(w http.ResponseWriter, status int, message string) {
+	writeProAPIErrorCode(w, status, strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")), message)
+}
+
+// writeProAPIErrorCode writes a Jamf Pro API error body with an explicit error code.
+func writeProAPIErrorCode(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"httpStatus": status,
+		"errors": []map[string]interface{}{{
+			"code":        code,
+			"field":       nil,
+			"description": message,
+			"id":          "0",
+		}},
+	})
+}
@@ -0,0 +1,287 @@
+// fakejamfpro_server.go
+// Package fakejamfpro provides an in-memory stand-in for a Jamf Pro tenant. It serves the Classic
+// API (/JSSResource), the Jamf Pro API (/api/v1, /api/v2, ...), the OAuth and bearer token endpoints
+// and the JCDS 2.0 upload flow over TLS so the provider can be exercised without network access.
+package fakejamfpro
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultClientID is the OAuth client id accepted by a new server. The HTTP client requires a UUID.
+	DefaultClientID = "00000000-0000-4000-8000-000000000000"
+	// DefaultClientSecret is the OAuth client secret accepted by a new server. It satisfies the HTTP
+	// client's length and character class checks.
+	DefaultClientSecret = "FakeClientSecret0"
+	// DefaultUsername is the basic auth username accepted by a new server.
+	DefaultUsername = "fake-admin"
+	// DefaultPassword is the basic auth password accepted by a new server.
+	DefaultPassword = "FakePassword0"
+)
+
+// Server is a fake Jamf Pro tenant backed by in-memory storage.
+type Server struct {
+	*httptest.Server
+
+	// Credentials accepted by the token endpoints.
+	ClientID     string
+	ClientSecret string
+	Username     string
+	Password     string
+
+	// TokenLifetime is the validity period of issued tokens. It must exceed the client's
+	// token refresh buffer period, otherwise every request triggers a refresh.
+	TokenLifetime time.Duration
+
+	mu               sync.Mutex
+	tokens           map[string]time.Time
+	classic          map[string]*classicCollection
+	proAPI           map[string]*proAPICollection
+	singletons       map[string]map[string]interface{}
+	objects          map[string][]byte
+	uploads          map[string]*multipartUpload
+	faults           []*Fault
+	notFoundOnCreate map[string]int
+	requests         []string
+}
+
+// New starts a fake Jamf Pro server listening on a random local port.
+func New() *Server {
+	return NewWithListener(nil)
+}
+
+// NewWithListener starts a fake Jamf Pro server on the given listener. A nil listener
+// selects a random local port.
+func NewWithListener(l net.Listener) *Server {
+	s := &Server{
+		ClientID:         DefaultClientID,
+		ClientSecret:     DefaultClientSecret,
+		Username:         DefaultUsername,
+		Password:         DefaultPassword,
+		TokenLifetime:    30 * time.Minute,
+		tokens:           make(map[string]time.Time),
+		classic:          make(map[string]*classicCollection),
+		proAPI:           make(map[string]*proAPICollection),
+		singletons:       make(map[string]map[string]interface{}),
+		objects:          make(map[string][]byte),
+		uploads:          make(map[string]*multipartUpload),
+		notFoundOnCreate: make(map[string]int),
+	}
+
+	s.singletons["jamf-pro-version"] = map[string]interface{}{"version": "11.5.0-t1715697224"}
+
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	if l != nil {
+		s.Server.Listener.Close()
+		s.Server.Listener = l
+	}
+	s.Server.StartTLS()
+
+	return s
+}
+
+// ProviderConfig returns a provider block that points the provider at this server. certPath must
+// hold the output of WriteCertificate, which the provider trusts through ca_bundle_file.
+func (s *Server) ProviderConfig(certPath string) string {
+	return fmt.Sprintf(`
+provider "jamfpro" {
+  jamf_url       = %q
+  ca_bundle_file = %q
+  client_id      = %q
+  client_secret  = %q
+}
+`, s.URL, certPath, s.ClientID, s.ClientSecret)
+}
+
+// CertificatePEM returns the server's self-signed certificate in PEM form.
+func (s *Server) CertificatePEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw})
+}
+
+// WriteCertificate writes the server certificate to path so that the provider can trust it through
+// its ca_bundle_file attribute, and out of process clients through SSL_CERT_FILE or AWS_CA_BUNDLE.
+func (s *Server) WriteCertificate(path string) error {
+	return os.WriteFile(path, s.CertificatePEM(), 0o600)
+}
+
+// Environment returns the environment variables that point the AWS SDK used for JCDS uploads
+// at this server. certPath must hold the output of WriteCertificate.
+func (s *Server) Environment(certPath string) map[string]string {
+	return map[string]string{
+		"AWS_ENDPOINT_URL_S3":       s.URL,
+		"AWS_CA_BUNDLE":             certPath,
+		"AWS_EC2_METADATA_DISABLED": "true",
+	}
+}
+
+// Requests returns the method and path of every request served so far, in order.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+// serveHTTP applies fault injection and authentication before dispatching the request to the
+// Classic API, Jamf Pro API or JCDS storage handlers.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	fault := s.matchFault(r)
+	s.mu.Unlock()
+
+	if fault != nil {
+		if fault.Delay > 0 {
+			select {
+			case <-time.After(fault.Delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.Status != 0 {
+			writeError(w, r, fault.Status, http.StatusText(fault.Status))
+			return
+		}
+	}
+
+	path := r.URL.Path
+	switch {
+	case path == "/api/oauth/token":
+		s.handleOAuthToken(w, r)
+	case path == "/api/v1/auth/token":
+		s.handleBearerToken(w, r)
+	case strings.HasPrefix(path, "/"+jcdsBucket+"/"):
+		s.handleStorage(w, r)
+	case !s.authorized(r):
+		writeError(w, r, http.StatusUnauthorized, "Unauthorized")
+	case path == "/api/v1/auth/keep-alive":
+		s.handleKeepAlive(w, r)
+	case path == "/api/v1/auth/invalidate-token":
+		s.handleInvalidateToken(w, r)
+	case strings.HasPrefix(path, "/api/v1/jcds/"):
+		s.handleJCDS(w, r)
+	case strings.HasPrefix(path, "/JSSResource/"):
+		s.handleClassic(w, r)
+	case strings.HasPrefix(path, "/api/"):
+		s.handleProAPI(w, r)
+	default:
+		writeError(w, r, http.StatusNotFound, "Not Found")
+	}
+}
+
+// handleOAuthToken issues an access token for a client_credentials grant.
+func (s *Server) handleOAuthToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, r, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if r.PostForm.Get("client_id") != s.ClientID || r.PostForm.Get("client_secret") != s.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	token, _ := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"scope":        "api-role:1",
+		"token_type":   "Bearer",
+		"expires_in":   int64(s.TokenLifetime / time.Second),
+	})
+}
+
+// handleBearerToken issues a bearer token for basic auth credentials.
+func (s *Server) handleBearerToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, r, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok || username != s.Username || password != s.Password {
+		writeError(w, r, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	token, expires := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"token": token, "expires": expires})
+}
+
+// handleKeepAlive replaces the caller's bearer token with a fresh one.
+func (s *Server) handleKeepAlive(w http.ResponseWriter, r *http.Request) {
+	s.revokeToken(bearerToken(r))
+	token, expires := s.issueToken()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"token": token, "expires": expires})
+}
+
+// handleInvalidateToken revokes the caller's bearer token.
+func (s *Server) handleInvalidateToken(w http.ResponseWriter, r *http.Request) {
+	s.revokeToken(bearerToken(r))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// issueToken records and returns a new random token along with its expiry time.
+func (s *Server) issueToken() (string, time.Time) {
+	expires := time.Now().Add(s.TokenLifetime).UTC()
+	token := randomHex(32)
+
+	s.mu.Lock()
+	s.tokens[token] = expires
+	s.mu.Unlock()
+
+	return token, expires
+}
+
+// revokeToken forgets a previously issued token.
+func (s *Server) revokeToken(token string) {
+	s.mu.Lock()
+	delete(s.tokens, token)
+	s.mu.Unlock()
+}
+
+// authorized reports whether the request carries a valid, unexpired bearer token.
+func (s *Server) authorized(r *http.Request) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expires, ok := s.tokens[bearerToken(r)]
+	return ok && time.Now().Before(expires)
+}
+
+// bearerToken extracts the token from the request's Authorization header.
+func bearerToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+// randomHex returns n random bytes encoded as a hex string.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// writeError writes an error body in the format the addressed API would use: HTML for the
+// Classic API, XML for JCDS storage and JSON for everything else.
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/JSSResource/"):
+		writeClassicError(w, status, message)
+	case strings.HasPrefix(r.URL.Path, "/"+jcdsBucket+"/"):
+		writeStorageError(w, status, http.StatusText(status), message)
+	default:
+		writeProAPIError(w, status, message)
+	}
+}
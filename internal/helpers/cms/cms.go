@@ -0,0 +1,251 @@
+// cms.go
+// This package contains a minimal CMS (PKCS #7) SignedData encoder used to sign configuration profiles
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+var (
+	oidData                   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidAttributeContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidAttributeMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidSHA256                 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidRSAEncryption          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidECDSAWithSHA256        = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+
+	asn1Null = asn1.RawValue{Tag: asn1.TagNull}
+)
+
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+type signerInfo struct {
+	Version            int
+	SID                issuerAndSerialNumber
+	DigestAlgorithm    algorithmIdentifier
+	SignedAttributes   asn1.RawValue
+	SignatureAlgorithm algorithmIdentifier
+	Signature          []byte
+}
+
+type encapsulatedContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	EncapContentInfo encapsulatedContentInfo
+	Certificates     asn1.RawValue
+	SignerInfos      asn1.RawValue
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+// Sign returns content wrapped in a DER encoded CMS SignedData structure, signed with key using
+// SHA-256. cert is the signing certificate and chain holds any intermediate certificates to embed.
+// RSA and ECDSA keys are supported. The output is the same for the same inputs: RSA signatures are
+// PKCS #1 v1.5, and ECDSA private keys sign with RFC 6979 nonces. Other ECDSA signers, such as
+// hardware keys, may produce a different signature each time.
+func Sign(content []byte, cert *x509.Certificate, chain []*x509.Certificate, key crypto.Signer) ([]byte, error) {
+	if public, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !public.Equal(cert.PublicKey) {
+		return nil, errors.New("the private key does not match the signing certificate")
+	}
+
+	var signatureAlgorithm algorithmIdentifier
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		signatureAlgorithm = algorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1Null}
+	case *ecdsa.PublicKey:
+		signatureAlgorithm = algorithmIdentifier{Algorithm: oidECDSAWithSHA256}
+	default:
+		return nil, fmt.Errorf("unsupported signing key type %T", key.Public())
+	}
+
+	contentDigest := sha256.Sum256(content)
+	signedAttributes, err := encodeSignedAttributes(contentDigest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature covers the signed attributes encoded as a SET OF, not as the [0] IMPLICIT field
+	attributesDigest := sha256.Sum256(signedAttributes.FullBytes)
+	var signature []byte
+	if ecdsaKey, ok := key.(*ecdsa.PrivateKey); ok {
+		signature, err = signECDSADeterministic(ecdsaKey, attributesDigest[:])
+	} else {
+		signature, err = key.Sign(rand.Reader, attributesDigest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign content: %v", err)
+	}
+
+	signer, err := asn1.Marshal(signerInfo{
+		Version: 1,
+		SID: issuerAndSerialNumber{
+			Issuer:       asn1.RawValue{FullBytes: cert.RawIssuer},
+			SerialNumber: cert.SerialNumber,
+		},
+		DigestAlgorithm: algorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1Null},
+		SignedAttributes: asn1.RawValue{
+			Class:      asn1.ClassContextSpecific,
+			Tag:        0,
+			IsCompound: true,
+			Bytes:      signedAttributes.Bytes,
+		},
+		SignatureAlgorithm: signatureAlgorithm,
+		Signature:          signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signer info: %v", err)
+	}
+
+	digestAlgorithm, err := asn1.Marshal(algorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1Null})
+	if err != nil {
+		return nil, err
+	}
+
+	encapsulatedContent, err := asn1.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates bytes.Buffer
+	certificates.Write(cert.Raw)
+	for _, intermediate := range chain {
+		certificates.Write(intermediate.Raw)
+	}
+
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: set(digestAlgorithm),
+		EncapContentInfo: encapsulatedContentInfo{
+			ContentType: oidData,
+			Content:     explicit(0, encapsulatedContent),
+		},
+		Certificates: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certificates.Bytes()},
+		SignerInfos:  set(signer),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signed data: %v", err)
+	}
+
+	return asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: explicit(0, sd)})
+}
+
+// encodeSignedAttributes returns the content type and message digest attributes as a DER SET OF.
+func encodeSignedAttributes(digest []byte) (asn1.RawValue, error) {
+	contentType, err := asn1.Marshal(oidData)
+	if err != nil {
+		return asn1.RawValue{}, err
+	}
+	messageDigest, err := asn1.Marshal(digest)
+	if err != nil {
+		return asn1.RawValue{}, err
+	}
+
+	var encoded [][]byte
+	for _, attr := range []attribute{
+		{Type: oidAttributeContentType, Values: set(contentType)},
+		{Type: oidAttributeMessageDigest, Values: set(messageDigest)},
+	} {
+		der, err := asn1.Marshal(attr)
+		if err != nil {
+			return asn1.RawValue{}, err
+		}
+		encoded = append(encoded, der)
+	}
+
+	raw := set(encoded...)
+	full, err := asn1.Marshal(raw)
+	if err != nil {
+		return asn1.RawValue{}, err
+	}
+	raw.FullBytes = full
+	return raw, nil
+}
+
+// set returns a DER SET OF holding the encoded elements in the sorted order DER requires.
+func set(elements ...[]byte) asn1.RawValue {
+	sorted := append([][]byte(nil), elements...)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	return asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: bytes.Join(sorted, nil)}
+}
+
+// explicit wraps an encoded value in an explicit context specific tag.
+func explicit(tag int, der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tag, IsCompound: true, Bytes: der}
+}
+
+// ParseCertificateChain parses PEM encoded certificates. The first certificate is returned as the
+// signing certificate and the rest as its chain.
+func ParseCertificateChain(pemData []byte) (*x509.Certificate, []*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(pemData); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, nil, errors.New("no PEM encoded certificate found")
+	}
+	return certs[0], certs[1:], nil
+}
+
+// ParsePrivateKey parses a PEM encoded PKCS #8, PKCS #1 or SEC 1 private key.
+func ParsePrivateKey(pemData []byte) (crypto.Signer, error) {
+	for block, rest := pem.Decode(pemData); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse PKCS #8 private key: %v", err)
+			}
+			signer, ok := key.(crypto.Signer)
+			if !ok {
+				return nil, fmt.Errorf("unsupported private key type %T", key)
+			}
+			return signer, nil
+		case "RSA PRIVATE KEY":
+			return x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			return x509.ParseECPrivateKey(block.Bytes)
+		}
+	}
+	return nil, errors.New("no PEM encoded private key found")
+}
@@ -0,0 +1,243 @@
+// cms_test.go
+package cms
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var testContent = []byte(`<?xml version="1.0" encoding="UTF-8"?><plist version="1.0"><dict/></plist>`)
+
+// newTestCertificate returns a self-signed certificate for key.
+func newTestCertificate(t *testing.T, key crypto.Signer) *x509.Certificate {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(42),
+		Subject:               pkix.Name{CommonName: "Profile Signing Test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func testKeys(t *testing.T) map[string]crypto.Signer {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]crypto.Signer{"RSA": rsaKey, "ECDSA": ecdsaKey}
+}
+
+// verifySignedData decodes a SignedData structure produced by Sign and checks its content,
+// message digest and signature against cert.
+func verifySignedData(t *testing.T, der []byte, cert *x509.Certificate) {
+	t.Helper()
+
+	var ci contentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil {
+		t.Fatalf("failed to decode content info: %v", err)
+	}
+	if !ci.ContentType.Equal(oidSignedData) {
+		t.Fatalf("content type is %v, not signed data", ci.ContentType)
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		t.Fatalf("failed to decode signed data: %v", err)
+	}
+
+	var content []byte
+	if _, err := asn1.Unmarshal(sd.EncapContentInfo.Content.Bytes, &content); err != nil {
+		t.Fatalf("failed to decode encapsulated content: %v", err)
+	}
+	if !bytes.Equal(content, testContent) {
+		t.Fatalf("encapsulated content is %q, want %q", content, testContent)
+	}
+
+	var signer signerInfo
+	if _, err := asn1.Unmarshal(sd.SignerInfos.Bytes, &signer); err != nil {
+		t.Fatalf("failed to decode signer info: %v", err)
+	}
+	if signer.SID.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+		t.Fatalf("signer serial number is %v, want %v", signer.SID.SerialNumber, cert.SerialNumber)
+	}
+
+	// The signature covers the signed attributes with their universal SET OF tag
+	attributes := append([]byte(nil), signer.SignedAttributes.FullBytes...)
+	attributes[0] = 0x31
+
+	var messageDigest []byte
+	rest := signer.SignedAttributes.Bytes
+	for len(rest) > 0 {
+		var attr attribute
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &attr); err != nil {
+			t.Fatalf("failed to decode signed attribute: %v", err)
+		}
+		if attr.Type.Equal(oidAttributeMessageDigest) {
+			if _, err := asn1.Unmarshal(attr.Values.Bytes, &messageDigest); err != nil {
+				t.Fatalf("failed to decode message digest: %v", err)
+			}
+		}
+	}
+	contentDigest := sha256.Sum256(testContent)
+	if !bytes.Equal(messageDigest, contentDigest[:]) {
+		t.Fatalf("message digest is %x, want %x", messageDigest, contentDigest)
+	}
+
+	algorithm := x509.SHA256WithRSA
+	if _, ok := cert.PublicKey.(*ecdsa.PublicKey); ok {
+		algorithm = x509.ECDSAWithSHA256
+	}
+	if err := cert.CheckSignature(algorithm, attributes, signer.Signature); err != nil {
+		t.Fatalf("signature does not verify: %v", err)
+	}
+}
+
+func TestSign(t *testing.T) {
+	for name, key := range testKeys(t) {
+		t.Run(name, func(t *testing.T) {
+			cert := newTestCertificate(t, key)
+
+			signed, err := Sign(testContent, cert, nil, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			verifySignedData(t, signed, cert)
+
+			again, err := Sign(testContent, cert, nil, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(signed, again) {
+				t.Fatal("signing the same content twice gave different output")
+			}
+		})
+	}
+}
+
+func TestSignRejectsMismatchedKey(t *testing.T) {
+	keys := testKeys(t)
+	cert := newTestCertificate(t, keys["RSA"])
+	if _, err := Sign(testContent, cert, nil, keys["ECDSA"]); err == nil {
+		t.Fatal("expected an error signing with a key that does not match the certificate")
+	}
+}
+
+// TestSignOpenSSL checks that OpenSSL accepts the signed data, when it is installed.
+func TestSignOpenSSL(t *testing.T) {
+	openssl, err := exec.LookPath("openssl")
+	if err != nil {
+		t.Skip("openssl is not installed")
+	}
+
+	for name, key := range testKeys(t) {
+		t.Run(name, func(t *testing.T) {
+			cert := newTestCertificate(t, key)
+			signed, err := Sign(testContent, cert, nil, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dir := t.TempDir()
+			signedFile := filepath.Join(dir, "profile.der")
+			certFile := filepath.Join(dir, "cert.pem")
+			outFile := filepath.Join(dir, "content")
+			if err := os.WriteFile(signedFile, signed, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			output, err := exec.Command(openssl, "cms", "-verify", "-binary", "-inform", "DER", "-in", signedFile, "-CAfile", certFile, "-purpose", "any", "-out", outFile).CombinedOutput()
+			if err != nil {
+				t.Fatalf("openssl cms -verify failed: %v: %s", err, output)
+			}
+			content, err := os.ReadFile(outFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(content, testContent) {
+				t.Fatalf("openssl extracted %q, want %q", content, testContent)
+			}
+		})
+	}
+}
+
+// TestSignECDSADeterministic checks the P-256 and SHA-256 test vectors of RFC 6979 appendix A.2.5.
+func TestSignECDSADeterministic(t *testing.T) {
+	key := &ecdsa.PrivateKey{D: hexInt(t, "C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721")}
+	key.Curve = elliptic.P256()
+	key.X = hexInt(t, "60FED4BA255A9D31C961EB74C6356D68C049B8923B61FA6CE669622E60F29FB6")
+	key.Y = hexInt(t, "7903FE1008B8BC99A41AE9E95628BC64F2F1B20C2D7E9F5177A3C294D4462299")
+
+	for _, tc := range []struct {
+		message string
+		r, s    string
+	}{
+		{
+			message: "sample",
+			r:       "EFD48B2AACB6A8FD1140DD9CD45E81D69D2C877B56AAF991C34D0EA84EAF3716",
+			s:       "F7CB1C942D657C41D436C7A1B6E29F65F3E900DBB9AFF4064DC4AB2F843ACDA8",
+		},
+		{
+			message: "test",
+			r:       "F1ABB023518351CD71D881567B1EA663ED3EFCF6C5132B354F28D3B0B7D38367",
+			s:       "019F4113742A2B14BD25926B49C649155F267E60D3814B4C0CC84250E46F0083",
+		},
+	} {
+		digest := sha256.Sum256([]byte(tc.message))
+		signature, err := signECDSADeterministic(key, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var rs struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(signature, &rs); err != nil {
+			t.Fatal(err)
+		}
+		if rs.R.Cmp(hexInt(t, tc.r)) != 0 || rs.S.Cmp(hexInt(t, tc.s)) != 0 {
+			t.Errorf("signature of %q is (%X, %X), want (%s, %s)", tc.message, rs.R, rs.S, tc.r, tc.s)
+		}
+	}
+}
+
+func hexInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return new(big.Int).SetBytes(b)
+}
@@ -0,0 +1,98 @@
+// rfc6979.go
+package cms
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+)
+
+// signECDSADeterministic signs a SHA-256 digest with the nonce derived from the key and digest as
+// described in RFC 6979, so the same content always gets the same signature. crypto/ecdsa mixes
+// random bytes into its nonce, which would change the signed profile on every read. The signature
+// is returned ASN.1 encoded, as ecdsa.SignASN1 returns it.
+func signECDSADeterministic(key *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	n := key.Curve.Params().N
+	qlen := n.BitLen()
+	rolen := (qlen + 7) / 8
+
+	// bits2int of RFC 6979 section 2.3.2, which is also how ECDSA reduces the digest to an integer
+	bits2int := func(b []byte) *big.Int {
+		v := new(big.Int).SetBytes(b)
+		if excess := len(b)*8 - qlen; excess > 0 {
+			v.Rsh(v, uint(excess))
+		}
+		return v
+	}
+	int2octets := func(v *big.Int) []byte {
+		return v.FillBytes(make([]byte, rolen))
+	}
+
+	e := bits2int(digest)
+	z := new(big.Int).Mod(e, n)
+
+	mac := func(key []byte, data ...[]byte) []byte {
+		h := hmac.New(sha256.New, key)
+		for _, d := range data {
+			h.Write(d)
+		}
+		return h.Sum(nil)
+	}
+
+	// Section 3.2, steps b to g
+	v := bytesOf(0x01, sha256.Size)
+	k := bytesOf(0x00, sha256.Size)
+	x := int2octets(key.D)
+	h1 := int2octets(z)
+	k = mac(k, v, []byte{0x00}, x, h1)
+	v = mac(k, v)
+	k = mac(k, v, []byte{0x01}, x, h1)
+	v = mac(k, v)
+
+	for attempt := 0; attempt < 64; attempt++ {
+		// Step h
+		var t []byte
+		for len(t) < rolen {
+			v = mac(k, v)
+			t = append(t, v...)
+		}
+		nonce := bits2int(t[:rolen])
+
+		if nonce.Sign() > 0 && nonce.Cmp(n) < 0 {
+			r, _ := key.Curve.ScalarBaseMult(int2octets(nonce))
+			r.Mod(r, n)
+			if r.Sign() != 0 {
+				s := new(big.Int).Mul(r, key.D)
+				s.Add(s, e)
+				s.Mul(s, new(big.Int).ModInverse(nonce, n))
+				s.Mod(s, n)
+				if s.Sign() != 0 {
+					signature, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+					if err != nil {
+						return nil, err
+					}
+					if !ecdsa.VerifyASN1(&key.PublicKey, digest, signature) {
+						return nil, errors.New("deterministic ECDSA signature failed to verify")
+					}
+					return signature, nil
+				}
+			}
+		}
+
+		k = mac(k, v, []byte{0x00})
+		v = mac(k, v)
+	}
+	return nil, errors.New("failed to derive an ECDSA nonce")
+}
+
+// bytesOf returns n copies of b.
+func bytesOf(b byte, n int) []byte {
+	result := make([]byte, n)
+	for i := range result {
+		result[i] = b
+	}
+	return result
+}
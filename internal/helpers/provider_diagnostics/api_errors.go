@@ -0,0 +1,261 @@
+// api_errors.go
+package provider_diagnostics
+
+/*
+The Jamf Pro SDK does not return typed errors: the HTTP client either serialises its APIError to
+JSON or formats the failure into a plain string, and the SDK then wraps the result with %v. The
+functions below recover the HTTP status code and the Jamf Pro error body from those messages so
+that callers can branch on the kind of failure instead of searching the message for "404".
+
+Recognised message shapes:
+  - {"StatusCode":404,"Type":"APIError",...,"Raw":"<body>"}            (GET, PUT and DELETE)
+  - received non-success status code: 409, raw response: <body>        (POST, unknown content type)
+  - received non-success status code with JSON response: <description>, raw response: <body>
+  - received HTML error content: Conflict - Error: Duplicate name       (Classic API status page)
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// ErrorKind classifies an API failure by the HTTP status code it carried.
+type ErrorKind string
+
+const (
+	ErrorKindUnknown     ErrorKind = "unknown"      // No status code could be recovered, e.g. a network failure.
+	ErrorKindNotFound    ErrorKind = "not found"    // 404
+	ErrorKindGone        ErrorKind = "gone"         // 410
+	ErrorKindConflict    ErrorKind = "conflict"     // 409, e.g. a duplicate name or a stale version lock.
+	ErrorKindAuth        ErrorKind = "unauthorized" // 401 and 403
+	ErrorKindRateLimited ErrorKind = "rate limited" // 429
+	ErrorKindServer      ErrorKind = "server error" // 5xx
+	ErrorKindValidation  ErrorKind = "invalid"      // 400, 422 and any other 4xx
+)
+
+// APIError is an error returned by the Jamf Pro SDK together with the details recovered from it.
+type APIError struct {
+	StatusCode int       // HTTP status code, 0 when unknown.
+	Kind       ErrorKind // Classification of StatusCode.
+	Message    string    // Jamf Pro error description, when one could be extracted.
+	Field      string    // Field named by the Jamf Pro error, when one was given.
+	Body       string    // Raw response body, when the HTTP client preserved it.
+	Err        error     // The original error.
+}
+
+// Error returns the original error message.
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	statusCodePattern = regexp.MustCompile(`status code: (\d{3})`)
+	httpStatusPattern = regexp.MustCompile(`"httpStatus"\s*:\s*(\d{3})`)
+	duplicatePattern  = regexp.MustCompile(`(?i)duplicate ([a-z_]+)`)
+	statusTextCodes   = buildStatusTextCodes()
+)
+
+const (
+	rawResponseMarker = "raw response: "
+	htmlErrorMarker   = "received HTML error content: "
+	jsonErrorMarker   = "received non-success status code with JSON response: "
+)
+
+// ClassifyError recovers the HTTP status, Jamf Pro error body and failing field from an error
+// returned by the SDK. It returns nil when err is nil.
+func ClassifyError(err error) *APIError {
+	if err == nil {
+		return nil
+	}
+
+	var classified *APIError
+	if errors.As(err, &classified) {
+		return classified
+	}
+
+	apiErr := &APIError{Err: err}
+	msg := err.Error()
+
+	var httpErr *httpclient.APIError
+	switch {
+	case errors.As(err, &httpErr):
+		apiErr.StatusCode, apiErr.Body = httpErr.StatusCode, httpErr.Raw
+	case strings.Contains(msg, `{"StatusCode":`):
+		var decoded httpclient.APIError
+		decoder := json.NewDecoder(strings.NewReader(msg[strings.Index(msg, `{"StatusCode":`):]))
+		if decoder.Decode(&decoded) == nil {
+			apiErr.StatusCode, apiErr.Body = decoded.StatusCode, decoded.Raw
+		}
+	case strings.Contains(msg, htmlErrorMarker):
+		apiErr.Message = msg[strings.Index(msg, htmlErrorMarker)+len(htmlErrorMarker):]
+		apiErr.StatusCode = statusTextCodes[strings.ToLower(strings.SplitN(apiErr.Message, " - ", 2)[0])]
+	default:
+		if m := statusCodePattern.FindStringSubmatch(msg); m != nil {
+			apiErr.StatusCode, _ = strconv.Atoi(m[1])
+		}
+		if i := strings.Index(msg, rawResponseMarker); i >= 0 {
+			apiErr.Body = msg[i+len(rawResponseMarker):]
+		}
+		if i := strings.Index(msg, jsonErrorMarker); i >= 0 && apiErr.Body != "" {
+			apiErr.Message = strings.SplitN(msg[i+len(jsonErrorMarker):], ", "+rawResponseMarker, 2)[0]
+		}
+	}
+
+	if apiErr.StatusCode == 0 {
+		if m := httpStatusPattern.FindStringSubmatch(msg); m != nil {
+			apiErr.StatusCode, _ = strconv.Atoi(m[1])
+		}
+	}
+
+	apiErr.parseBody()
+	if apiErr.Field == "" {
+		if m := duplicatePattern.FindStringSubmatch(apiErr.Message); m != nil {
+			apiErr.Field = strings.ToLower(m[1])
+		}
+	}
+	apiErr.Kind = kindForStatus(apiErr.StatusCode)
+
+	return apiErr
+}
+
+// parseBody extracts the description and field from a Jamf Pro API JSON error body, or the
+// status page text from a Classic API HTML body.
+func (e *APIError) parseBody() {
+	body := strings.TrimSpace(e.Body)
+	if body == "" {
+		return
+	}
+
+	if strings.HasPrefix(body, "{") {
+		var response struct {
+			HTTPStatus int `json:"httpStatus"`
+			Errors     []struct {
+				Code        string  `json:"code"`
+				Field       *string `json:"field"`
+				Description string  `json:"description"`
+			} `json:"errors"`
+		}
+		if json.Unmarshal([]byte(body), &response) != nil || len(response.Errors) == 0 {
+			return
+		}
+		first := response.Errors[0]
+		if e.Message == "" {
+			e.Message = first.Description
+			if e.Message == "" {
+				e.Message = first.Code
+			}
+		}
+		if first.Field != nil {
+			e.Field = *first.Field
+		}
+		if e.StatusCode == 0 {
+			e.StatusCode = response.HTTPStatus
+		}
+		return
+	}
+
+	if e.Message == "" && strings.Contains(body, "<p") {
+		e.Message = htmlParagraphs(body)
+	}
+}
+
+// kindForStatus maps an HTTP status code to its ErrorKind.
+func kindForStatus(status int) ErrorKind {
+	switch {
+	case status == http.StatusNotFound:
+		return ErrorKindNotFound
+	case status == http.StatusGone:
+		return ErrorKindGone
+	case status == http.StatusConflict:
+		return ErrorKindConflict
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return ErrorKindAuth
+	case status == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case status >= 500:
+		return ErrorKindServer
+	case status >= 400:
+		return ErrorKindValidation
+	default:
+		return ErrorKindUnknown
+	}
+}
+
+// IsNotFound reports whether err is a 404 or 410 response, meaning the object no longer exists.
+func IsNotFound(err error) bool {
+	apiErr := ClassifyError(err)
+	return apiErr != nil && (apiErr.Kind == ErrorKindNotFound || apiErr.Kind == ErrorKindGone)
+}
+
+// IsConflict reports whether err is a 409 response.
+func IsConflict(err error) bool {
+	apiErr := ClassifyError(err)
+	return apiErr != nil && apiErr.Kind == ErrorKindConflict
+}
+
+// IsRetryable reports whether repeating the request could succeed: rate limiting, server errors
+// and failures without a status code, such as dropped connections.
+func IsRetryable(err error) bool {
+	apiErr := ClassifyError(err)
+	if apiErr == nil {
+		return false
+	}
+	switch apiErr.Kind {
+	case ErrorKindRateLimited, ErrorKindServer, ErrorKindUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryError wraps err for use in a retry.RetryContext callback, ending the retry loop early
+// for failures that repeating the request cannot fix, such as a 404, 409 or 401.
+func RetryError(err error) *retry.RetryError {
+	if err == nil {
+		return nil
+	}
+	if IsRetryable(err) {
+		return retry.RetryableError(err)
+	}
+	return retry.NonRetryableError(err)
+}
+
+// htmlParagraphs joins the text of the <p> elements of a Classic API status page.
+func htmlParagraphs(body string) string {
+	var paragraphs []string
+	for _, part := range strings.Split(body, "<p")[1:] {
+		start := strings.Index(part, ">")
+		end := strings.Index(part, "</p>")
+		if start < 0 || end < start {
+			continue
+		}
+		if text := strings.TrimSpace(part[start+1 : end]); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	return strings.Join(paragraphs, " - ")
+}
+
+// buildStatusTextCodes maps lower cased HTTP status texts, as shown on Classic API status pages,
+// back to their status codes.
+func buildStatusTextCodes() map[string]int {
+	codes := make(map[string]int)
+	for code := 400; code < 600; code++ {
+		if text := http.StatusText(code); text != "" {
+			codes[strings.ToLower(text)] = code
+		}
+	}
+	return codes
+}
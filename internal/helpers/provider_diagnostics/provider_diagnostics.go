@@ -1,29 +1,85 @@
 // provider_diagnostics.go
 package provider_diagnostics
 
-/*
-// Helper function to generate diagnostics based on the error type.
-func generateTFDiagsFromHTTPError(err error, d *schema.ResourceData, action string) diag.Diagnostics {
-	var diags diag.Diagnostics
-	resourceName, exists := d.GetOk("name")
-	if !exists {
-		resourceName = "unknown"
-	}
-
-	// Handle the APIError in the diagnostic
-	if apiErr, ok := err.(*.APIError); ok {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  fmt.Sprintf("Failed to %s the resource with name: %s", action, resourceName),
-			Detail:   fmt.Sprintf("API Error (Code: %d): %s", apiErr.StatusCode, apiErr.Message),
-		})
-	} else {
-		diags = append(diags, diag.Diagnostic{
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GenerateTFDiagsFromHTTPError converts an error returned by a Jamf Pro SDK call into diagnostics
+// that carry the HTTP status, the Jamf Pro error body and, when Jamf Pro names the offending field
+// and it exists in the resource configuration, the attribute path involved. The summary describes
+// the operation that failed, for example "failed to create Jamf Pro Site 'HQ'".
+func GenerateTFDiagsFromHTTPError(err error, d *schema.ResourceData, summary string) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	apiErr := ClassifyError(err)
+	if apiErr.StatusCode == 0 {
+		return diag.Diagnostics{{
 			Severity: diag.Error,
-			Summary:  fmt.Sprintf("Failed to %s the resource with name: %s", action, resourceName),
+			Summary:  summary,
 			Detail:   err.Error(),
-		})
+		}}
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "Jamf Pro responded with HTTP %d %s (%s).", apiErr.StatusCode, http.StatusText(apiErr.StatusCode), apiErr.Kind)
+	if apiErr.Message != "" {
+		fmt.Fprintf(&detail, "\n\nError: %s", apiErr.Message)
+	}
+	if apiErr.Field != "" {
+		fmt.Fprintf(&detail, "\nField: %s", apiErr.Field)
+	}
+	if apiErr.Body != "" && apiErr.Body != apiErr.Message {
+		fmt.Fprintf(&detail, "\n\nResponse body: %s", apiErr.Body)
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("%s: %s", summary, apiErr.Kind),
+		Detail:        detail.String(),
+		AttributePath: attributePath(d, apiErr.Field),
+	}}
+}
+
+// attributePath maps a Jamf Pro field name such as "siteId" or "general.name" to the top level
+// attribute of the same name, returning nil when the resource configuration has no such attribute.
+func attributePath(d *schema.ResourceData, field string) cty.Path {
+	if d == nil || field == "" {
+		return nil
+	}
+
+	segments := strings.Split(field, ".")
+	for _, segment := range []string{segments[0], segments[len(segments)-1]} {
+		name := toSnakeCase(segment)
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.Type().IsObjectType() || !config.Type().HasAttribute(name) {
+			continue
+		}
+		return cty.GetAttrPath(name)
+	}
+	return nil
+}
+
+// toSnakeCase converts a camelCase Jamf Pro API field name to the snake_case used in schemas.
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
 	}
-	return diags
+	return b.String()
 }
-*/
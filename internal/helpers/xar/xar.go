@@ -0,0 +1,185 @@
+// xar.go
+// This package contains a minimal reader for xar archives, the container format of flat macOS installer packages
+package xar
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+)
+
+const (
+	// magic is "xar!", the first four bytes of every xar archive.
+	magic = 0x78617221
+	// headerSize is the size of the fixed part of the archive header.
+	headerSize = 28
+	// maxTOCSize bounds the uncompressed table of contents, which lists file names and offsets only.
+	maxTOCSize = 64 * 1024 * 1024
+)
+
+// ErrNotXar is returned by Open when the file is not a xar archive, such as a disk image.
+var ErrNotXar = errors.New("not a xar archive")
+
+// header is the fixed part of the xar archive header. All values are big endian.
+type header struct {
+	Magic                 uint32
+	Size                  uint16
+	Version               uint16
+	TOCLengthCompressed   uint64
+	TOCLengthUncompressed uint64
+	ChecksumAlgorithm     uint32
+}
+
+// toc is the table of contents, stored as zlib compressed XML after the header.
+type toc struct {
+	Files []tocFile `xml:"toc>file"`
+}
+
+// tocFile is a file or directory in the table of contents. Directories hold their entries in Files.
+type tocFile struct {
+	Name  string    `xml:"name"`
+	Type  string    `xml:"type"`
+	Data  *tocData  `xml:"data"`
+	Files []tocFile `xml:"file"`
+}
+
+// tocData locates the contents of a file within the heap.
+type tocData struct {
+	Length   int64 `xml:"length"`
+	Offset   int64 `xml:"offset"`
+	Size     int64 `xml:"size"`
+	Encoding struct {
+		Style string `xml:"style,attr"`
+	} `xml:"encoding"`
+}
+
+// Archive is an open xar archive.
+type Archive struct {
+	file     *os.File
+	heapBase int64
+	files    map[string]*tocData
+	names    []string
+}
+
+// Open opens the xar archive at filePath and reads its table of contents. It returns ErrNotXar when
+// the file is not a xar archive.
+func Open(filePath string) (*Archive, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := newArchive(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return archive, nil
+}
+
+// newArchive parses the header and table of contents of file.
+func newArchive(file *os.File) (*Archive, error) {
+	var hdr header
+	if err := binary.Read(file, binary.BigEndian, &hdr); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrNotXar
+		}
+		return nil, fmt.Errorf("failed to read xar header: %v", err)
+	}
+	if hdr.Magic != magic {
+		return nil, ErrNotXar
+	}
+	if hdr.Size < headerSize {
+		return nil, fmt.Errorf("invalid xar header size %d", hdr.Size)
+	}
+	if hdr.TOCLengthUncompressed > maxTOCSize {
+		return nil, fmt.Errorf("xar table of contents of %d bytes exceeds the %d byte limit", hdr.TOCLengthUncompressed, maxTOCSize)
+	}
+
+	compressed := io.NewSectionReader(file, int64(hdr.Size), int64(hdr.TOCLengthCompressed))
+	zr, err := zlib.NewReader(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress xar table of contents: %v", err)
+	}
+	defer zr.Close()
+
+	var contents toc
+	if err := xml.NewDecoder(io.LimitReader(zr, int64(hdr.TOCLengthUncompressed))).Decode(&contents); err != nil {
+		return nil, fmt.Errorf("failed to parse xar table of contents: %v", err)
+	}
+
+	archive := &Archive{
+		file:     file,
+		heapBase: int64(hdr.Size) + int64(hdr.TOCLengthCompressed),
+		files:    make(map[string]*tocData),
+	}
+	archive.index("", contents.Files)
+
+	return archive, nil
+}
+
+// index records the regular files in entries under their slash separated paths.
+func (a *Archive) index(dir string, entries []tocFile) {
+	for _, entry := range entries {
+		name := path.Join(dir, entry.Name)
+		if entry.Type == "file" && entry.Data != nil {
+			a.files[name] = entry.Data
+			a.names = append(a.names, name)
+		}
+		a.index(name, entry.Files)
+	}
+}
+
+// Files returns the paths of the regular files in the archive, in table of contents order.
+func (a *Archive) Files() []string {
+	return append([]string(nil), a.names...)
+}
+
+// ReadFile returns the contents of the file at name, a slash separated path such as
+// "Distribution" or "component.pkg/PackageInfo". Files larger than maxSize bytes are rejected.
+func (a *Archive) ReadFile(name string, maxSize int64) ([]byte, error) {
+	data, ok := a.files[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, os.ErrNotExist)
+	}
+	if data.Size > maxSize {
+		return nil, fmt.Errorf("%s is %d bytes, more than the %d byte limit", name, data.Size, maxSize)
+	}
+
+	var reader io.Reader = io.NewSectionReader(a.file, a.heapBase+data.Offset, data.Length)
+	switch data.Encoding.Style {
+	case "", "application/octet-stream":
+	case "application/x-gzip":
+		zr, err := zlib.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress %s: %v", name, err)
+		}
+		defer zr.Close()
+		reader = zr
+	case "application/x-bzip2":
+		reader = bzip2.NewReader(reader)
+	default:
+		return nil, fmt.Errorf("%s uses unsupported encoding %q", name, data.Encoding.Style)
+	}
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(reader, maxSize+1)); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", name, err)
+	}
+	if int64(buf.Len()) > maxSize {
+		return nil, fmt.Errorf("%s is more than the %d byte limit", name, maxSize)
+	}
+	return buf.Bytes(), nil
+}
+
+// Close closes the underlying file.
+func (a *Archive) Close() error {
+	return a.file.Close()
+}
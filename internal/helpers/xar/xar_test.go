@@ -0,0 +1,85 @@
+// xar_test.go
+package xar
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/xar/xartest"
+)
+
+// writeArchive writes a xar archive holding files to a temporary file and returns its path.
+func writeArchive(t *testing.T, files []xartest.File) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), "test.pkg")
+	if err := xartest.Write(filePath, files); err != nil {
+		t.Fatal(err)
+	}
+	return filePath
+}
+
+func TestOpenReadsFiles(t *testing.T) {
+	distribution := `<installer-gui-script minSpecVersion="2"><product id="com.example.app" version="1.2.3"/></installer-gui-script>`
+	packageInfo := `<pkg-info identifier="com.example.app.pkg" version="1.2.3" postinstall-action="restart"/>`
+
+	archive, err := Open(writeArchive(t, []xartest.File{
+		{Path: "Distribution", Contents: distribution},
+		{Path: "component.pkg/PackageInfo", Contents: packageInfo, Compressed: true},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer archive.Close()
+
+	if files, want := archive.Files(), []string{"Distribution", "component.pkg/PackageInfo"}; !reflect.DeepEqual(files, want) {
+		t.Fatalf("files are %q, want %q", files, want)
+	}
+
+	for name, want := range map[string]string{"Distribution": distribution, "component.pkg/PackageInfo": packageInfo} {
+		contents, err := archive.ReadFile(name, 1024)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+		if string(contents) != want {
+			t.Errorf("%s is %q, want %q", name, contents, want)
+		}
+	}
+}
+
+func TestReadFileErrors(t *testing.T) {
+	archive, err := Open(writeArchive(t, []xartest.File{{Path: "Distribution", Contents: strings.Repeat("x", 100)}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer archive.Close()
+
+	if _, err := archive.ReadFile("PackageInfo", 1024); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("reading a missing file returned %v, want os.ErrNotExist", err)
+	}
+	if _, err := archive.ReadFile("Distribution", 10); err == nil || !strings.Contains(err.Error(), "limit") {
+		t.Errorf("reading a file over the size limit returned %v, want a limit error", err)
+	}
+}
+
+func TestOpenNotXar(t *testing.T) {
+	for name, contents := range map[string]string{
+		"empty":      "",
+		"short":      "xar",
+		"disk image": strings.Repeat("\x00", 512) + "koly",
+	} {
+		t.Run(name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "test.dmg")
+			if err := os.WriteFile(filePath, []byte(contents), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Open(filePath); !errors.Is(err, ErrNotXar) {
+				t.Fatalf("Open returned %v, want ErrNotXar", err)
+			}
+		})
+	}
+}
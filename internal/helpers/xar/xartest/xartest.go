@@ -0,0 +1,89 @@
+// xartest.go
+// This package writes small xar archives, such as synthetic flat installer packages, for tests
+package xartest
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// File is a file written to an archive by Write.
+type File struct {
+	// Path is the slash separated path of the file, such as "Distribution" or
+	// "component.pkg/PackageInfo". Files in a directory are nested in a directory entry.
+	Path     string
+	Contents string
+	// Compressed stores the file zlib compressed, as the "application/x-gzip" encoding.
+	Compressed bool
+}
+
+// Write writes a xar archive holding files to filePath.
+func Write(filePath string, files []File) error {
+	var heap bytes.Buffer
+	var toc strings.Builder
+	toc.WriteString(`<?xml version="1.0" encoding="UTF-8"?><xar><toc>`)
+	for i, file := range files {
+		data, style := []byte(file.Contents), "application/octet-stream"
+		if file.Compressed {
+			compressed, err := compress([]byte(file.Contents))
+			if err != nil {
+				return err
+			}
+			data, style = compressed, "application/x-gzip"
+		}
+
+		dir, name := path.Split(file.Path)
+		dirs := strings.Split(strings.Trim(dir, "/"), "/")
+		if dir == "" {
+			dirs = nil
+		}
+		for j, d := range dirs {
+			fmt.Fprintf(&toc, `<file id="%d"><name>%s</name><type>directory</type>`, (i+1)*100+j, d)
+		}
+		fmt.Fprintf(&toc, `<file id="%d"><name>%s</name><type>file</type><data><length>%d</length><offset>%d</offset><size>%d</size><encoding style="%s"/></data></file>`,
+			i+1, name, len(data), heap.Len(), len(file.Contents), style)
+		toc.WriteString(strings.Repeat(`</file>`, len(dirs)))
+		heap.Write(data)
+	}
+	toc.WriteString(`</toc></xar>`)
+
+	compressedTOC, err := compress([]byte(toc.String()))
+	if err != nil {
+		return err
+	}
+
+	var archive bytes.Buffer
+	// The header: magic, header size, version, compressed and uncompressed table of contents
+	// lengths and checksum algorithm, which is none
+	if err := binary.Write(&archive, binary.BigEndian, struct {
+		Magic                 uint32
+		Size                  uint16
+		Version               uint16
+		TOCLengthCompressed   uint64
+		TOCLengthUncompressed uint64
+		ChecksumAlgorithm     uint32
+	}{0x78617221, 28, 1, uint64(len(compressedTOC)), uint64(toc.Len()), 0}); err != nil {
+		return err
+	}
+	archive.Write(compressedTOC)
+	archive.Write(heap.Bytes())
+
+	return os.WriteFile(filePath, archive.Bytes(), 0o600)
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
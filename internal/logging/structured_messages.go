@@ -42,6 +42,14 @@ const (
 	MsgAPIDeleteFailedAfterRetry = "Final attempt to delete %s failed"
 	MsgAPIDeleteSuccess          = "%s successfully removed from Terraform state"
 
+	// JCDS
+	MsgJCDSUploadProgress = "Uploaded %d of %d parts of %s to JCDS 2.0"
+	MsgJCDSUploadRetry    = "Resuming JCDS 2.0 upload of %s after a transient error"
+	MsgJCDSUploadSuccess  = "%s uploaded to JCDS 2.0 and verified"
+
+	// Provider configuration
+	MsgProxyConfigured = "Sending Jamf Pro API requests and JCDS uploads through proxy %s"
+
 	// Others
 	MsgTypeConversionFailure = "Failed to convert %s to %s for %s"
 )
@@ -358,6 +366,52 @@ func LogAPIDeleteSuccess(ctx context.Context, resourceType, resourceID, resource
 	})
 }
 
+// JCDS
+
+// LogJCDSUploadProgress provides structured logging for each part of a file uploaded to JCDS 2.0
+func LogJCDSUploadProgress(ctx context.Context, fileName string, partNumber, completedParts, totalParts int, uploadedBytes, totalBytes int64) {
+	logMessage := fmt.Sprintf(MsgJCDSUploadProgress, completedParts, totalParts, fileName)
+
+	percent := 100.0
+	if totalBytes > 0 {
+		percent = float64(uploadedBytes) / float64(totalBytes) * 100
+	}
+
+	Info(ctx, SubsystemUpload, logMessage, map[string]interface{}{
+		"file_name":       fileName,
+		"part_number":     partNumber,
+		"completed_parts": completedParts,
+		"total_parts":     totalParts,
+		"uploaded_bytes":  uploadedBytes,
+		"total_bytes":     totalBytes,
+		"percent":         fmt.Sprintf("%.1f", percent),
+	})
+}
+
+// LogJCDSUploadRetry provides structured logging for a JCDS 2.0 upload resumed after a transient error
+func LogJCDSUploadRetry(ctx context.Context, fileName string, completedParts, totalParts int, errorMsg string) {
+	logMessage := fmt.Sprintf(MsgJCDSUploadRetry, fileName)
+
+	Warn(ctx, SubsystemUpload, logMessage, map[string]interface{}{
+		"file_name":       fileName,
+		"completed_parts": completedParts,
+		"total_parts":     totalParts,
+		"error":           errorMsg,
+	})
+}
+
+// LogJCDSUploadSuccess provides structured logging for a JCDS 2.0 upload whose hash matched the local file
+func LogJCDSUploadSuccess(ctx context.Context, fileName, fileURI, md5Hash string, totalBytes int64) {
+	logMessage := fmt.Sprintf(MsgJCDSUploadSuccess, fileName)
+
+	Info(ctx, SubsystemUpload, logMessage, map[string]interface{}{
+		"file_name":   fileName,
+		"uri":         fileURI,
+		"md5":         md5Hash,
+		"total_bytes": totalBytes,
+	})
+}
+
 // LogTypeConversionFailure provides structured logging for errors during type conversion
 func LogTypeConversionFailure(ctx context.Context, fromType, toType, resourceType, resourceID, errorMsg string) {
 	logMessage := fmt.Sprintf(MsgTypeConversionFailure, fromType, toType, resourceType)
@@ -370,3 +424,17 @@ func LogTypeConversionFailure(ctx context.Context, fromType, toType, resourceTyp
 		"error":         errorMsg,
 	})
 }
+
+// Provider configuration
+
+// LogProxyConfigured provides structured logging for the proxy configured for the provider. The proxy
+// URL must not hold credentials; mask the username with MaskSensitiveData where it is sensitive.
+func LogProxyConfigured(ctx context.Context, proxyURL, username string, noProxy []string) {
+	logMessage := fmt.Sprintf(MsgProxyConfigured, proxyURL)
+
+	Info(ctx, SubsystemConfig, logMessage, map[string]interface{}{
+		"proxy_url":      proxyURL,
+		"proxy_username": username,
+		"no_proxy":       noProxy,
+	})
+}
@@ -24,6 +24,7 @@ const (
 	SubsystemInit       LogSubsystem = "init"       // For provider initialization
 	SubsystemCleanup    LogSubsystem = "cleanup"    // For cleanup operations
 	SubsystemConstruct  LogSubsystem = "construct"  // For resource construction operations
+	SubsystemUpload     LogSubsystem = "upload"     // For file uploads such as JCDS packages
 	SubsystemGeneral    LogSubsystem = "general"
 	// Add more subsystems as needed
 )
@@ -4,6 +4,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	jamfprohandler "github.com/deploymenttheory/go-api-http-client/apihandlers/jamfpro"
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
@@ -29,11 +32,14 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/computergroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/computerinventory"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/computerprestageenrollments"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/computerprestagescope"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/departments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/diskencryptionconfigurations"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/dockitems"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/filesharedistributionpoints"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/macosconfigurationprofiles"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/mobiledeviceinventory"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/mobiledeviceprestageenrollments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/networksegments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/packages"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/policies"
@@ -41,6 +47,7 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/scripts"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/sites"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/endpoints/usergroups"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/logging"
 )
 
 // TerraformProviderProductUserAgent is included in the User-Agent header for
@@ -54,12 +61,154 @@ func GetInstanceName(d *schema.ResourceData) (string, error) {
 	if instanceName == "" {
 		instanceName = os.Getenv("JAMFPRO_INSTANCE_NAME")
 		if instanceName == "" {
-			return "", fmt.Errorf("instance_name must be provided either as an environment variable (JAMFPRO_INSTANCE_NAME) or in the Terraform configuration")
+			return "", fmt.Errorf("instance_name must be provided either as an environment variable (JAMFPRO_INSTANCE_NAME) or in the Terraform configuration, unless jamf_url is set")
 		}
 	}
 	return instanceName, nil
 }
 
+// GetCredentialSource returns the source of credentials that are read outside the Terraform
+// configuration: the 'credential_process' command, or the 'client_secret_file' or 'password_file'
+// files with the configured client ID or username. It returns nil when none are set.
+func GetCredentialSource(d *schema.ResourceData) (client.CredentialSource, error) {
+	if process := d.Get("credential_process").(string); process != "" {
+		refreshBuffer := time.Duration(d.Get("token_refresh_buffer_period").(int)) * time.Minute
+		return client.NewCredentialProcess(process, refreshBuffer), nil
+	}
+
+	files := client.CredentialFiles{
+		ClientSecretFile: d.Get("client_secret_file").(string),
+		PasswordFile:     d.Get("password_file").(string),
+	}
+	if files.ClientSecretFile == "" && files.PasswordFile == "" {
+		return nil, nil
+	}
+	if files.ClientSecretFile != "" {
+		clientID, err := GetClientID(d)
+		if err != nil {
+			return nil, fmt.Errorf("client_secret_file requires client_id: %v", err)
+		}
+		files.ClientID = clientID
+	}
+	if files.PasswordFile != "" {
+		username, err := GetClientUsername(d)
+		if err != nil {
+			return nil, fmt.Errorf("password_file requires username: %v", err)
+		}
+		files.Username = username
+	}
+	return files, nil
+}
+
+// defaultReferenceSchema returns the schema of the default_site and default_category blocks.
+func defaultReferenceSchema(kind string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: fmt.Sprintf("The ID of the default %s. Looked up from 'name' when not set.", kind),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("The name of the default %s. Looked up from 'id' when not set.", kind),
+			},
+		},
+	}
+}
+
+// getDefaultReference returns the default site or category configured in the key block, or nil
+// when the block is not set.
+func getDefaultReference(d *schema.ResourceData, key string, newReference func(id int, name string) *client.DefaultReference) (*client.DefaultReference, error) {
+	blocks := d.Get(key).([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil, nil
+	}
+
+	block := blocks[0].(map[string]interface{})
+	id, _ := block["id"].(int)
+	name, _ := block["name"].(string)
+	if id == 0 && name == "" {
+		return nil, fmt.Errorf("'%s' must set 'id', 'name' or both", key)
+	}
+	return newReference(id, name), nil
+}
+
+// GetJamfURL retrieves and parses the 'jamf_url' value from the Terraform configuration or the
+// JAMFPRO_URL environment variable. It returns nil when neither is set.
+func GetJamfURL(d *schema.ResourceData) (*url.URL, error) {
+	jamfURL := d.Get("jamf_url").(string)
+	if jamfURL == "" {
+		jamfURL = os.Getenv("JAMFPRO_URL")
+		if jamfURL == "" {
+			return nil, nil
+		}
+	}
+	return client.ParseBaseURL(jamfURL)
+}
+
+// validateJamfURL validates the 'jamf_url' attribute.
+func validateJamfURL(v interface{}, k string) (warnings []string, errs []error) {
+	if value, ok := v.(string); ok && value != "" {
+		if _, err := client.ParseBaseURL(value); err != nil {
+			errs = append(errs, fmt.Errorf("%q: %v", k, err))
+		}
+	}
+	return warnings, errs
+}
+
+// GetProxyOptions retrieves the proxy settings from the Terraform configuration or the
+// JAMFPRO_PROXY_URL, JAMFPRO_PROXY_USERNAME and JAMFPRO_PROXY_PASSWORD environment variables.
+func GetProxyOptions(d *schema.ResourceData) (client.ProxyOptions, error) {
+	options := client.ProxyOptions{
+		URL:      d.Get("proxy_url").(string),
+		Username: d.Get("proxy_username").(string),
+		Password: d.Get("proxy_password").(string),
+	}
+	for _, host := range d.Get("no_proxy").([]interface{}) {
+		if host, ok := host.(string); ok && host != "" {
+			options.NoProxy = append(options.NoProxy, host)
+		}
+	}
+
+	if options.IsZero() {
+		if options.Username != "" || len(options.NoProxy) > 0 {
+			return options, fmt.Errorf("proxy_username and no_proxy require proxy_url")
+		}
+		return options, nil
+	}
+	if options.Password != "" && options.Username == "" {
+		return options, fmt.Errorf("proxy_password requires proxy_username")
+	}
+	if _, err := options.ProxyURL(); err != nil {
+		return options, err
+	}
+	return options, nil
+}
+
+// validateProxyURL validates the 'proxy_url' attribute without including it in errors, as it may
+// hold credentials.
+func validateProxyURL(v interface{}, k string) (warnings []string, errs []error) {
+	if value, ok := v.(string); ok && value != "" {
+		if _, err := client.ParseProxyURL(value); err != nil {
+			errs = append(errs, fmt.Errorf("%q: %v", k, err))
+		}
+	}
+	return warnings, errs
+}
+
+// nonEmpty returns the values that are not empty.
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
 // GetClientID retrieves the 'client_id' value from the Terraform configuration.
 // If it's not present in the configuration, it attempts to fetch it from the JAMFPRO_CLIENT_ID environment variable.
 func GetClientID(d *schema.ResourceData) (string, error) {
@@ -118,9 +267,17 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("JAMFPRO_INSTANCE_NAME", ""),
-				Description: "The Jamf Pro instance name. For https://mycompany.jamfcloud.com, define 'mycompany' in this field.",
+				Description: "The Jamf Pro instance name. For https://mycompany.jamfcloud.com, define 'mycompany' in this field. Either this or 'jamf_url' is required.",
+			},
+			"jamf_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("JAMFPRO_URL", ""),
+				ConflictsWith: []string{"instance_name", "override_base_domain"},
+				ValidateFunc:  validateJamfURL,
+				Description:   "The full base URL of Jamf Pro, including the scheme and any port and path prefix, such as https://jss.example.com:8443 for an on-premises server. Use instead of 'instance_name' and 'override_base_domain', over which it takes precedence.",
 			},
 			"client_id": {
 				Type:        schema.TypeString,
@@ -148,6 +305,27 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("JAMFPRO_PASSWORD", ""),
 				Description: "The Jamf Pro password used for authentication.",
 			},
+			"client_secret_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("JAMFPRO_CLIENT_SECRET_FILE", ""),
+				ConflictsWith: []string{"client_secret"},
+				Description:   "Path to a file holding the Jamf Pro Client secret, such as one mounted by a secret store. The file is read again each time a token is requested, so a rotated secret is picked up. Used with 'client_id' instead of 'client_secret'.",
+			},
+			"password_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("JAMFPRO_PASSWORD_FILE", ""),
+				ConflictsWith: []string{"password"},
+				Description:   "Path to a file holding the Jamf Pro password. The file is read again each time a token is requested. Used with 'username' instead of 'password'.",
+			},
+			"credential_process": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("JAMFPRO_CREDENTIAL_PROCESS", ""),
+				ConflictsWith: []string{"client_id", "client_secret", "username", "password", "client_secret_file", "password_file"},
+				Description:   "A command, run through the system shell, that prints the credentials as a JSON object with 'client_id' and 'client_secret', or 'username' and 'password', and an optional RFC 3339 'expiry'. The command is run again when a token is requested within 'token_refresh_buffer_period' of the expiry.",
+			},
 			"log_level": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -216,6 +394,93 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Base domain override used when the default in the API handler isn't suitable.",
 			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("JAMFPRO_CA_BUNDLE_FILE", ""),
+				Description: "Path to a PEM file of CA certificates to trust, in addition to the system roots, when verifying the Jamf Pro server certificate, such as an internal CA.",
+			},
+			"client_certificate_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("JAMFPRO_CLIENT_CERTIFICATE_FILE", ""),
+				RequiredWith: []string{"client_key_file"},
+				Description:  "Path to a PEM client certificate presented to Jamf Pro, or a proxy in front of it, for mutual TLS. Requires 'client_key_file'.",
+			},
+			"client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("JAMFPRO_CLIENT_KEY_FILE", ""),
+				RequiredWith: []string{"client_certificate_file"},
+				Description:  "Path to the PEM private key of 'client_certificate_file'.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip verification of the Jamf Pro server certificate. Only use this for testing; prefer 'ca_bundle_file' for servers with certificates from an internal CA.",
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("JAMFPRO_PROXY_URL", ""),
+				ValidateFunc: validateProxyURL,
+				Description:  "The URL of an http, https or socks5 proxy to send Jamf Pro API requests and JCDS package uploads through, such as http://proxy.example.com:3128. When not set, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables apply.",
+			},
+			"proxy_username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("JAMFPRO_PROXY_USERNAME", ""),
+				RequiredWith: []string{"proxy_url"},
+				Description:  "The username for proxy authentication. Masked in logs when 'hide_sensitive_data' is set.",
+			},
+			"proxy_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("JAMFPRO_PROXY_PASSWORD", ""),
+				RequiredWith: []string{"proxy_username"},
+				Description:  "The password for proxy authentication. Masked in logs when 'hide_sensitive_data' is set.",
+			},
+			"no_proxy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"proxy_url"},
+				Description:  "Hosts, domains and CIDR ranges that are reached directly rather than through 'proxy_url', in the same forms as the NO_PROXY environment variable, such as 'jss.example.com', '.example.com' or '10.0.0.0/8'.",
+			},
+			"default_site": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The site applied to every resource that supports a site and does not set one, such as policies, groups, searches and configuration profiles. A site set on a resource always takes precedence.",
+				Elem:        defaultReferenceSchema("site"),
+			},
+			"default_category": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The category applied to every resource that supports a category and does not set one, such as scripts, packages, policies, printers and configuration profiles. A category set on a resource always takes precedence.",
+				Elem:        defaultReferenceSchema("category"),
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("JAMFPRO_READ_ONLY", false),
+				Description: "Block every create, update and delete with an error before Jamf Pro is called, such as for drift detection with credentials that can write. Reads, imports and data sources work as normal. Can also be set with the JAMFPRO_READ_ONLY environment variable.",
+			},
+			"allowed_resource_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The resource types, such as 'jamfpro_script', that may be created, updated and deleted. All others fail at apply. When not set, all resource types may be changed.",
+			},
+			"denied_resource_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The resource types, such as 'jamfpro_policy', that may not be created, updated or deleted. Takes precedence over 'allowed_resource_types'.",
+			},
 			"api_type": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -224,82 +489,167 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"jamfpro_account":                       accounts.DataSourceJamfProAccounts(),
-			"jamfpro_account_group":                 accountgroups.DataSourceJamfProAccountGroups(),
-			"jamfpro_api_integration":               apiintegrations.DataSourceJamfProApiIntegrations(),
-			"jamfpro_api_role":                      apiroles.DataSourceJamfProAPIRoles(),
-			"jamfpro_building":                      buildings.DataSourceJamfProBuildings(),
-			"jamfpro_category":                      categories.DataSourceJamfProCategories(),
-			"jamfpro_computer_extension_attribute":  computerextensionattributes.DataSourceJamfProComputerExtensionAttributes(),
-			"jamfpro_computer_group":                computergroups.DataSourceJamfProComputerGroups(),
-			"jamfpro_computer_inventory":            computerinventory.DataSourceJamfProComputerInventory(),
-			"jamfpro_computer_prestage_enrollment":  computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentEnrollment(),
-			"jamfpro_department":                    departments.DataSourceJamfProDepartments(),
-			"jamfpro_disk_encryption_configuration": diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurations(),
-			"jamfpro_dock_item":                     dockitems.DataSourceJamfProDockItems(),
-			"jamfpro_file_share_distribution_point": filesharedistributionpoints.DataSourceJamfProFileShareDistributionPoints(),
-			"jamfpro_network_segment":               networksegments.DataSourceJamfProNetworkSegments(),
-			"jamfpro_package":                       packages.DataSourceJamfProPackages(),
+			"jamfpro_account":                             accounts.DataSourceJamfProAccounts(),
+			"jamfpro_account_group":                       accountgroups.DataSourceJamfProAccountGroups(),
+			"jamfpro_account_groups":                      accountgroups.DataSourceJamfProAccountGroupsList(),
+			"jamfpro_accounts":                            accounts.DataSourceJamfProAccountsList(),
+			"jamfpro_advanced_computer_searches":          advancedcomputersearches.DataSourceJamfProAdvancedComputerSearchesList(),
+			"jamfpro_advanced_mobile_device_searches":     advancedmobiledevicesearches.DataSourceJamfProAdvancedMobileDeviceSearchesList(),
+			"jamfpro_advanced_user_searches":              advancedusersearches.DataSourceJamfProAdvancedUserSearchesList(),
+			"jamfpro_allowed_file_extensions":             allowedfileextensions.DataSourceJamfProAllowedFileExtensionsList(),
+			"jamfpro_api_integration":                     apiintegrations.DataSourceJamfProApiIntegrations(),
+			"jamfpro_api_integrations":                    apiintegrations.DataSourceJamfProApiIntegrationsList(),
+			"jamfpro_api_role":                            apiroles.DataSourceJamfProAPIRoles(),
+			"jamfpro_api_roles":                           apiroles.DataSourceJamfProAPIRolesList(),
+			"jamfpro_building":                            buildings.DataSourceJamfProBuildings(),
+			"jamfpro_buildings":                           buildings.DataSourceJamfProBuildingsList(),
+			"jamfpro_categories":                          categories.DataSourceJamfProCategoriesList(),
+			"jamfpro_category":                            categories.DataSourceJamfProCategories(),
+			"jamfpro_computer_extension_attribute":        computerextensionattributes.DataSourceJamfProComputerExtensionAttributes(),
+			"jamfpro_computer_extension_attributes":       computerextensionattributes.DataSourceJamfProComputerExtensionAttributesList(),
+			"jamfpro_computer_group":                      computergroups.DataSourceJamfProComputerGroups(),
+			"jamfpro_computer_groups":                     computergroups.DataSourceJamfProComputerGroupsList(),
+			"jamfpro_computer_inventory":                  computerinventory.DataSourceJamfProComputerInventory(),
+			"jamfpro_computer_prestage_enrollment":        computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentEnrollment(),
+			"jamfpro_computer_prestage_enrollments":       computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentsList(),
+			"jamfpro_computers_inventory":                 computerinventory.DataSourceJamfProComputersInventory(),
+			"jamfpro_department":                          departments.DataSourceJamfProDepartments(),
+			"jamfpro_departments":                         departments.DataSourceJamfProDepartmentsList(),
+			"jamfpro_disk_encryption_configuration":       diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurations(),
+			"jamfpro_disk_encryption_configurations":      diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurationsList(),
+			"jamfpro_dock_item":                           dockitems.DataSourceJamfProDockItems(),
+			"jamfpro_dock_items":                          dockitems.DataSourceJamfProDockItemsList(),
+			"jamfpro_file_share_distribution_point":       filesharedistributionpoints.DataSourceJamfProFileShareDistributionPoints(),
+			"jamfpro_file_share_distribution_points":      filesharedistributionpoints.DataSourceJamfProFileShareDistributionPointsList(),
+			"jamfpro_macos_configuration_profile_payload": macosconfigurationprofiles.DataSourceJamfProMacOSConfigurationProfilePayload(),
+			"jamfpro_macos_configuration_profiles":        macosconfigurationprofiles.DataSourceJamfProMacOSConfigurationProfilesList(),
+			"jamfpro_mobile_device_inventory":             mobiledeviceinventory.DataSourceJamfProMobileDeviceInventory(),
+			"jamfpro_mobile_device_prestage_enrollment":   mobiledeviceprestageenrollments.DataSourceJamfProMobileDevicePrestageEnrollment(),
+			"jamfpro_mobile_device_prestage_enrollments":  mobiledeviceprestageenrollments.DataSourceJamfProMobileDevicePrestageEnrollmentsList(),
+			"jamfpro_mobile_devices_inventory":            mobiledeviceinventory.DataSourceJamfProMobileDevicesInventory(),
+			"jamfpro_network_segment":                     networksegments.DataSourceJamfProNetworkSegments(),
+			"jamfpro_network_segments":                    networksegments.DataSourceJamfProNetworkSegmentsList(),
+			"jamfpro_package":                             packages.DataSourceJamfProPackages(),
+			"jamfpro_packages":                            packages.DataSourceJamfProPackagesList(),
+			"jamfpro_policies":                            policies.DataSourceJamfProPoliciesList(),
 			// "jamfpro_policy":                        policies.DataSourceJamfProPolicies(),
-			"jamfpro_printer":    printers.DataSourceJamfProPrinters(),
-			"jamfpro_script":     scripts.DataSourceJamfProScripts(),
-			"jamfpro_site":       sites.DataSourceJamfProSites(),
-			"jamfpro_user_group": usergroups.DataSourceJamfProUserGroups(),
+			"jamfpro_printer":     printers.DataSourceJamfProPrinters(),
+			"jamfpro_printers":    printers.DataSourceJamfProPrintersList(),
+			"jamfpro_script":      scripts.DataSourceJamfProScripts(),
+			"jamfpro_scripts":     scripts.DataSourceJamfProScriptsList(),
+			"jamfpro_site":        sites.DataSourceJamfProSites(),
+			"jamfpro_sites":       sites.DataSourceJamfProSitesList(),
+			"jamfpro_user_group":  usergroups.DataSourceJamfProUserGroups(),
+			"jamfpro_user_groups": usergroups.DataSourceJamfProUserGroupsList(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"jamfpro_account":                       accounts.ResourceJamfProAccounts(),
-			"jamfpro_account_group":                 accountgroups.ResourceJamfProAccountGroups(),
-			"jamfpro_advanced_computer_search":      advancedcomputersearches.ResourceJamfProAdvancedComputerSearches(),
-			"jamfpro_advanced_mobile_device_search": advancedmobiledevicesearches.ResourceJamfProAdvancedMobileDeviceSearches(),
-			"jamfpro_advanced_user_search":          advancedusersearches.ResourceJamfProAdvancedUserSearches(),
-			"jamfpro_allowed_file_extension":        allowedfileextensions.ResourceJamfProAllowedFileExtensions(),
-			"jamfpro_api_integration":               apiintegrations.ResourceJamfProApiIntegrations(),
-			"jamfpro_api_role":                      apiroles.ResourceJamfProAPIRoles(),
-			"jamfpro_building":                      buildings.ResourceJamfProBuildings(),
-			"jamfpro_category":                      categories.ResourceJamfProCategories(),
-			"jamfpro_computer_checkin":              computercheckin.ResourceJamfProComputerCheckin(),
-			"jamfpro_computer_extension_attribute":  computerextensionattributes.ResourceJamfProComputerExtensionAttributes(),
-			"jamfpro_computer_group":                computergroups.ResourceJamfProComputerGroups(),
-			"jamfpro_computer_prestage_enrollment":  computerprestageenrollments.ResourceJamfProComputerPrestageEnrollmentEnrollment(),
-			"jamfpro_department":                    departments.ResourceJamfProDepartments(),
-			"jamfpro_disk_encryption_configuration": diskencryptionconfigurations.ResourceJamfProDiskEncryptionConfigurations(),
-			"jamfpro_dock_item":                     dockitems.ResourceJamfProDockItems(),
-			"jamfpro_file_share_distribution_point": filesharedistributionpoints.ResourceJamfProFileShareDistributionPoints(),
-			"jamfpro_network_segment":               networksegments.ResourceJamfProNetworkSegments(),
-			"jamfpro_macos_configuration_profile":   macosconfigurationprofiles.ResourceJamfProMacOSConfigurationProfiles(),
-			"jamfpro_package":                       packages.ResourceJamfProPackages(),
-			"jamfpro_policy":                        policies.ResourceJamfProPolicies(),
-			"jamfpro_printer":                       printers.ResourceJamfProPrinters(),
-			"jamfpro_script":                        scripts.ResourceJamfProScripts(),
-			"jamfpro_site":                          sites.ResourceJamfProSites(),
-			"jamfpro_user_group":                    usergroups.ResourceJamfProUserGroups(),
+			"jamfpro_account":                           accounts.ResourceJamfProAccounts(),
+			"jamfpro_account_group":                     accountgroups.ResourceJamfProAccountGroups(),
+			"jamfpro_advanced_computer_search":          advancedcomputersearches.ResourceJamfProAdvancedComputerSearches(),
+			"jamfpro_advanced_mobile_device_search":     advancedmobiledevicesearches.ResourceJamfProAdvancedMobileDeviceSearches(),
+			"jamfpro_advanced_user_search":              advancedusersearches.ResourceJamfProAdvancedUserSearches(),
+			"jamfpro_allowed_file_extension":            allowedfileextensions.ResourceJamfProAllowedFileExtensions(),
+			"jamfpro_api_integration":                   apiintegrations.ResourceJamfProApiIntegrations(),
+			"jamfpro_api_role":                          apiroles.ResourceJamfProAPIRoles(),
+			"jamfpro_building":                          buildings.ResourceJamfProBuildings(),
+			"jamfpro_category":                          categories.ResourceJamfProCategories(),
+			"jamfpro_computer_checkin":                  computercheckin.ResourceJamfProComputerCheckin(),
+			"jamfpro_computer_extension_attribute":      computerextensionattributes.ResourceJamfProComputerExtensionAttributes(),
+			"jamfpro_computer_group":                    computergroups.ResourceJamfProComputerGroups(),
+			"jamfpro_computer_group_membership":         computergroups.ResourceJamfProComputerGroupMembership(),
+			"jamfpro_computer_prestage_enrollment":      computerprestageenrollments.ResourceJamfProComputerPrestageEnrollmentEnrollment(),
+			"jamfpro_computer_prestage_scope":           computerprestagescope.ResourceJamfProComputerPrestageScope(),
+			"jamfpro_department":                        departments.ResourceJamfProDepartments(),
+			"jamfpro_disk_encryption_configuration":     diskencryptionconfigurations.ResourceJamfProDiskEncryptionConfigurations(),
+			"jamfpro_dock_item":                         dockitems.ResourceJamfProDockItems(),
+			"jamfpro_file_share_distribution_point":     filesharedistributionpoints.ResourceJamfProFileShareDistributionPoints(),
+			"jamfpro_mobile_device_prestage_enrollment": mobiledeviceprestageenrollments.ResourceJamfProMobileDevicePrestageEnrollment(),
+			"jamfpro_network_segment":                   networksegments.ResourceJamfProNetworkSegments(),
+			"jamfpro_macos_configuration_profile":       macosconfigurationprofiles.ResourceJamfProMacOSConfigurationProfiles(),
+			"jamfpro_package":                           packages.ResourceJamfProPackages(),
+			"jamfpro_policy":                            policies.ResourceJamfProPolicies(),
+			"jamfpro_printer":                           printers.ResourceJamfProPrinters(),
+			"jamfpro_script":                            scripts.ResourceJamfProScripts(),
+			"jamfpro_site":                              sites.ResourceJamfProSites(),
+			"jamfpro_user_group":                        usergroups.ResourceJamfProUserGroups(),
+			"jamfpro_user_group_membership":             usergroups.ResourceJamfProUserGroupMembership(),
 		},
 	}
 
-	provider.ConfigureContextFunc = func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var diags diag.Diagnostics
 
-		instanceName, err := GetInstanceName(d)
+		baseURL, err := GetJamfURL(d)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "Error getting instance name",
+				Summary:  "Invalid Jamf Pro URL",
 				Detail:   err.Error(),
 			})
 			return nil, diags
 		}
 
-		// Attempt to get client credentials (Client ID and Secret) or user credentials (Username and Password)
-		clientID, errClientID := GetClientID(d)
-		clientSecret, errClientSecret := GetClientSecret(d)
-		username, errUsername := GetClientUsername(d)
-		password, errPassword := GetClientPassword(d)
+		var instanceName string
+		if baseURL != nil {
+			// Only used in logs, as the base URL handler builds the request URLs
+			instanceName = baseURL.Host
+		} else {
+			instanceName, err = GetInstanceName(d)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Error getting instance name",
+					Detail:   err.Error(),
+				})
+				return nil, diags
+			}
+		}
+
+		// Credentials read from files or a credential process are read again for each token
+		credentialSource, err := GetCredentialSource(d)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid Authentication Configuration",
+				Detail:   err.Error(),
+			})
+			return nil, diags
+		}
+
+		var clientID, clientSecret, username, password string
+		if credentialSource != nil {
+			credentials, err := credentialSource.Credentials()
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Error getting credentials",
+					Detail:   err.Error(),
+				})
+				return nil, diags
+			}
+			clientID, clientSecret = credentials.ClientID, credentials.ClientSecret
+			username, password = credentials.Username, credentials.Password
+		} else {
+			// Attempt to get client credentials (Client ID and Secret) or user credentials (Username and Password)
+			var errClientID, errClientSecret, errUsername, errPassword error
+			clientID, errClientID = GetClientID(d)
+			clientSecret, errClientSecret = GetClientSecret(d)
+			username, errUsername = GetClientUsername(d)
+			password, errPassword = GetClientPassword(d)
+			if errClientID != nil || errClientSecret != nil {
+				clientID, clientSecret = "", ""
+			}
+			if errUsername != nil || errPassword != nil {
+				username, password = "", ""
+			}
+		}
 
 		// Check if either pair of credentials is provided, prioritizing Client ID/Secret
-		if errClientID == nil && errClientSecret == nil && clientID != "" && clientSecret != "" {
+		if clientID != "" && clientSecret != "" {
 			// Client ID and Client Secret are provided
 			// Initialize client with OAuth credentials
-		} else if errUsername == nil && errPassword == nil && username != "" && password != "" {
+			username, password = "", ""
+		} else if username != "" && password != "" {
 			// Username and Password are provided
 			// Initialize client with Username/Password credentials
 		} else {
@@ -307,7 +657,7 @@ func Provider() *schema.Provider {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Invalid Authentication Configuration",
-				Detail:   "You must provide either a valid 'client_id' and 'client_secret' pair or a 'username' and 'password' pair for authentication.",
+				Detail:   "You must provide either a valid 'client_id' and 'client_secret' or 'client_secret_file' pair, a 'username' and 'password' or 'password_file' pair, or a 'credential_process' for authentication.",
 			})
 			return nil, diags
 		}
@@ -348,12 +698,107 @@ func Provider() *schema.Provider {
 			return nil, diag.FromErr(err)
 		}
 
+		// The HTTP client keeps the base domain override on the client but builds URLs from the
+		// API handler, so pass it through or every request falls back to jamfcloud.com.
+		if handler, ok := httpclient.HTTP.APIHandler.(*jamfprohandler.JamfAPIHandler); ok {
+			handler.OverrideBaseDomain = httpClientConfig.Environment.OverrideBaseDomain
+		}
+		if baseURL != nil {
+			httpclient.HTTP.APIHandler = &client.BaseURLHandler{APIHandler: httpclient.HTTP.APIHandler, BaseURL: baseURL}
+		}
+
+		tlsOptions := client.TLSOptions{
+			CABundleFile:          d.Get("ca_bundle_file").(string),
+			ClientCertificateFile: d.Get("client_certificate_file").(string),
+			ClientKeyFile:         d.Get("client_key_file").(string),
+			InsecureSkipVerify:    d.Get("insecure_skip_verify").(bool),
+		}
+		proxyOptions, err := GetProxyOptions(d)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid Proxy Configuration",
+				Detail:   err.Error(),
+			})
+			return nil, diags
+		}
+
 		// Initialize your provider's APIClient struct with the Jamf Pro HTTP client.
 		jamfProAPIClient := client.APIClient{
 			Conn: httpclient,
 		}
 
+		if !proxyOptions.IsZero() {
+			if d.Get("hide_sensitive_data").(bool) {
+				ctx = logging.MaskSensitiveData(ctx, logging.SubsystemConfig, nil, nonEmpty(proxyOptions.Username, proxyOptions.Password))
+			}
+			logging.LogProxyConfigured(ctx, proxyOptions.Redacted(), proxyOptions.Username, proxyOptions.NoProxy)
+
+			uploadTransport, err := client.NewTransport(client.TLSOptions{}, proxyOptions)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid Proxy Configuration",
+					Detail:   err.Error(),
+				})
+				return nil, diags
+			}
+			jamfProAPIClient.UploadHTTPClient = &http.Client{Transport: uploadTransport}
+		}
+
+		jamfProAPIClient.DefaultSite, err = getDefaultReference(d, "default_site", client.NewDefaultSite)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		jamfProAPIClient.DefaultCategory, err = getDefaultReference(d, "default_category", client.NewDefaultCategory)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		jamfProAPIClient.Mutations, err = getMutationPolicy(d, provider.ResourcesMap)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid Resource Type Restrictions",
+				Detail:   err.Error(),
+			})
+			return nil, diags
+		}
+
+		var transport http.RoundTripper
+		if !tlsOptions.IsZero() || !proxyOptions.IsZero() {
+			transport, err = client.NewTransport(tlsOptions, proxyOptions)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid TLS Configuration",
+					Detail:   err.Error(),
+				})
+				return nil, diags
+			}
+			if tlsOptions.InsecureSkipVerify {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "TLS Verification Disabled",
+					Detail:   "'insecure_skip_verify' is set, so the Jamf Pro server certificate is not verified.",
+				})
+			}
+		}
+		// Token requests of this client, and no other, are sent with the current credentials
+		if credentialSource != nil {
+			handler := httpclient.HTTP.APIHandler
+			transport = client.NewCredentialTransport(transport, credentialSource, handler.GetOAuthTokenEndpoint(), handler.GetBearerTokenEndpoint())
+		}
+		if transport != nil {
+			if err := client.SetTransport(httpclient.HTTP, transport); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
 		return &jamfProAPIClient, diags
 	}
+
+	// Every resource checks the mutation policy of the configured provider before changing Jamf Pro
+	guardMutations(provider.ResourcesMap)
+
 	return provider
 }
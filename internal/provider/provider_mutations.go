@@ -0,0 +1,78 @@
+// provider_mutations.go
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// guardMutations wraps the create, update and delete functions of every resource so that they
+// return an error without calling Jamf Pro when the provider's mutation policy does not allow them.
+func guardMutations(resources map[string]*schema.Resource) {
+	for resourceType, resource := range resources {
+		resource.CreateContext = schema.CreateContextFunc(guardMutation(resourceType, "create", mutationFunc(resource.CreateContext)))
+		resource.UpdateContext = schema.UpdateContextFunc(guardMutation(resourceType, "update", mutationFunc(resource.UpdateContext)))
+		resource.DeleteContext = schema.DeleteContextFunc(guardMutation(resourceType, "delete", mutationFunc(resource.DeleteContext)))
+	}
+}
+
+// mutationFunc is the signature shared by the create, update and delete functions of a resource.
+type mutationFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+// guardMutation returns next, checked against the mutation policy in the provider meta before it
+// is called. A nil next is returned unchanged.
+func guardMutation(resourceType, operation string, next mutationFunc) mutationFunc {
+	if next == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if apiclient, ok := meta.(*client.APIClient); ok {
+			if err := apiclient.Mutations.Check(resourceType, operation); err != nil {
+				return diag.Diagnostics{{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Cannot %s %s", operation, resourceType),
+					Detail:   err.Error(),
+				}}
+			}
+		}
+		return next(ctx, d, meta)
+	}
+}
+
+// getMutationPolicy reads the 'read_only', 'allowed_resource_types' and 'denied_resource_types'
+// values from the Terraform configuration, checking that each listed resource type exists.
+func getMutationPolicy(d *schema.ResourceData, resources map[string]*schema.Resource) (client.MutationPolicy, error) {
+	policy := client.MutationPolicy{
+		ReadOnly:             d.Get("read_only").(bool),
+		AllowedResourceTypes: getStringList(d, "allowed_resource_types"),
+		DeniedResourceTypes:  getStringList(d, "denied_resource_types"),
+	}
+
+	var unknown []string
+	for _, resourceType := range append(append([]string{}, policy.AllowedResourceTypes...), policy.DeniedResourceTypes...) {
+		if _, ok := resources[resourceType]; !ok {
+			unknown = append(unknown, resourceType)
+		}
+	}
+	if len(unknown) > 0 {
+		return policy, fmt.Errorf("unknown resource types: %s", strings.Join(unknown, ", "))
+	}
+	return policy, nil
+}
+
+// getStringList returns the non-empty strings of the list attribute key.
+func getStringList(d *schema.ResourceData, key string) []string {
+	var values []string
+	for _, v := range d.Get(key).([]interface{}) {
+		if s, ok := v.(string); ok && s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,1040 @@
+// provider_test.go
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/fakejamfpro"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// startFakeJamfPro starts a fake Jamf Pro server for the test and returns it with the path of its
+// certificate, which the provider trusts through ca_bundle_file.
+func startFakeJamfPro(t *testing.T) (*fakejamfpro.Server, string) {
+	t.Helper()
+
+	server := fakejamfpro.New()
+	t.Cleanup(server.Close)
+
+	certPath := filepath.Join(t.TempDir(), "fakejamfpro.pem")
+	if err := server.WriteCertificate(certPath); err != nil {
+		t.Fatal(err)
+	}
+	return server, certPath
+}
+
+// configureProvider returns a provider configured against server, with any extra attributes.
+func configureProvider(t *testing.T, server *fakejamfpro.Server, certPath string, extra map[string]interface{}) *schema.Provider {
+	t.Helper()
+
+	config := map[string]interface{}{
+		"jamf_url":       server.URL,
+		"ca_bundle_file": certPath,
+		"client_id":      server.ClientID,
+		"client_secret":  server.ClientSecret,
+	}
+	for k, v := range extra {
+		config[k] = v
+	}
+
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("failed to configure the provider: %v", diags)
+	}
+	return p
+}
+
+// lifecycle drives a resource through the same calls Terraform makes for plan, apply, refresh and
+// import, without the Terraform CLI.
+type lifecycle struct {
+	t            *testing.T
+	provider     *schema.Provider
+	resourceType string
+	resource     *schema.Resource
+}
+
+func newLifecycle(t *testing.T, p *schema.Provider, resourceType string) *lifecycle {
+	return &lifecycle{t: t, provider: p, resourceType: resourceType, resource: p.ResourcesMap[resourceType]}
+}
+
+// config returns the resource configuration holding attributes, with every other attribute null.
+// Strings, ints and bools are converted, and blocks are given as cty values or as lists of maps.
+func (l *lifecycle) config(attributes map[string]interface{}) (*terraform.ResourceConfig, cty.Value) {
+	l.t.Helper()
+
+	block := l.resource.CoreConfigSchema()
+	values := map[string]cty.Value{}
+	for name, attribute := range block.Attributes {
+		values[name] = cty.NullVal(attribute.Type)
+	}
+	for name, nested := range block.BlockTypes {
+		switch fmt.Sprint(nested.Nesting) {
+		case "NestingList":
+			values[name] = cty.ListValEmpty(nested.Block.ImpliedType())
+		case "NestingSet":
+			values[name] = cty.SetValEmpty(nested.Block.ImpliedType())
+		default:
+			values[name] = cty.NullVal(nested.Block.ImpliedType())
+		}
+	}
+	for name, value := range attributes {
+		current, ok := values[name]
+		if !ok {
+			l.t.Fatalf("%s has no attribute '%s'", l.resourceType, name)
+		}
+		converted, err := configValue(value, current.Type())
+		if err != nil {
+			l.t.Fatalf("unsupported value for '%s': %v", name, err)
+		}
+		values[name] = converted
+	}
+
+	raw := cty.ObjectVal(values)
+	return terraform.NewResourceConfigShimmed(raw, block), raw
+}
+
+// configValue converts value to a cty value of type ty. Lists of maps become blocks, and the
+// attributes a map leaves out are null, or empty when they are nested blocks.
+func configValue(value interface{}, ty cty.Type) (cty.Value, error) {
+	switch v := value.(type) {
+	case cty.Value:
+		return v, nil
+	case string:
+		return cty.StringVal(v), nil
+	case int:
+		return cty.NumberIntVal(int64(v)), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	case []interface{}:
+		if !ty.IsListType() && !ty.IsSetType() {
+			return cty.NilVal, fmt.Errorf("a list is not a %s", ty.FriendlyName())
+		}
+		elements := make([]cty.Value, 0, len(v))
+		for _, element := range v {
+			converted, err := configValue(element, ty.ElementType())
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elements = append(elements, converted)
+		}
+		switch {
+		case len(elements) == 0 && ty.IsSetType():
+			return cty.SetValEmpty(ty.ElementType()), nil
+		case len(elements) == 0:
+			return cty.ListValEmpty(ty.ElementType()), nil
+		case ty.IsSetType():
+			return cty.SetVal(elements), nil
+		}
+		return cty.ListVal(elements), nil
+	case map[string]interface{}:
+		if !ty.IsObjectType() {
+			return cty.NilVal, fmt.Errorf("a map is not a %s", ty.FriendlyName())
+		}
+		for name := range v {
+			if !ty.HasAttribute(name) {
+				return cty.NilVal, fmt.Errorf("unknown attribute '%s'", name)
+			}
+		}
+		attributes := map[string]cty.Value{}
+		for name, attributeType := range ty.AttributeTypes() {
+			if value, ok := v[name]; ok {
+				converted, err := configValue(value, attributeType)
+				if err != nil {
+					return cty.NilVal, fmt.Errorf("%s: %w", name, err)
+				}
+				attributes[name] = converted
+				continue
+			}
+			switch {
+			case attributeType.IsListType() && attributeType.ElementType().IsObjectType():
+				attributes[name] = cty.ListValEmpty(attributeType.ElementType())
+			case attributeType.IsSetType() && attributeType.ElementType().IsObjectType():
+				attributes[name] = cty.SetValEmpty(attributeType.ElementType())
+			default:
+				attributes[name] = cty.NullVal(attributeType)
+			}
+		}
+		return cty.ObjectVal(attributes), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported value %v", value)
+}
+
+// apply plans attributes against state and applies the plan, returning the new state.
+func (l *lifecycle) apply(state *terraform.InstanceState, attributes map[string]interface{}) *terraform.InstanceState {
+	l.t.Helper()
+
+	config, raw := l.config(attributes)
+	prior := &terraform.InstanceState{Attributes: map[string]string{}}
+	if state != nil {
+		prior = state.DeepCopy()
+	}
+	prior.RawConfig = raw
+
+	diff, err := l.resource.Diff(context.Background(), prior, config, l.provider.Meta())
+	if err != nil {
+		l.t.Fatalf("failed to plan %s: %v", l.resourceType, err)
+	}
+	if diff == nil {
+		return state
+	}
+	diff.RawConfig = raw
+
+	newState, diags := l.resource.Apply(context.Background(), state, diff, l.provider.Meta())
+	if diags.HasError() {
+		l.t.Fatalf("failed to apply %s: %v", l.resourceType, diags)
+	}
+	return newState
+}
+
+// assertNoChanges fails the test unless planning attributes against state plans no changes. When
+// prefixes are given, only changes to attributes starting with one of them are considered.
+func (l *lifecycle) assertNoChanges(state *terraform.InstanceState, attributes map[string]interface{}, prefixes ...string) {
+	l.t.Helper()
+
+	config, raw := l.config(attributes)
+	prior := state.DeepCopy()
+	prior.RawConfig = raw
+
+	diff, err := l.resource.Diff(context.Background(), prior, config, l.provider.Meta())
+	if err != nil {
+		l.t.Fatalf("failed to plan %s: %v", l.resourceType, err)
+	}
+	if diff == nil || diff.Empty() {
+		return
+	}
+	var changes []string
+	for key, change := range diff.Attributes {
+		if hasAnyPrefix(key, prefixes) {
+			changes = append(changes, fmt.Sprintf("%s: %q => %q", key, change.Old, change.New))
+		}
+	}
+	if len(changes) == 0 {
+		return
+	}
+	sort.Strings(changes)
+	l.t.Errorf("%s plans changes after apply:\n%s", l.resourceType, strings.Join(changes, "\n"))
+}
+
+// hasAnyPrefix reports whether key starts with one of prefixes, or prefixes is empty.
+func hasAnyPrefix(key string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// refresh reads the resource, returning nil when it no longer exists.
+func (l *lifecycle) refresh(state *terraform.InstanceState) *terraform.InstanceState {
+	l.t.Helper()
+
+	newState, diags := l.resource.RefreshWithoutUpgrade(context.Background(), state, l.provider.Meta())
+	if diags.HasError() {
+		l.t.Fatalf("failed to read %s: %v", l.resourceType, diags)
+	}
+	if newState == nil || newState.ID == "" {
+		return nil
+	}
+	return newState
+}
+
+// importState imports the resource with importID and reads it.
+func (l *lifecycle) importState(importID string) *terraform.InstanceState {
+	l.t.Helper()
+
+	d := l.resource.Data(&terraform.InstanceState{ID: importID})
+	imported, err := l.resource.Importer.StateContext(context.Background(), d, l.provider.Meta())
+	if err != nil {
+		l.t.Fatalf("failed to import %s '%s': %v", l.resourceType, importID, err)
+	}
+	if len(imported) != 1 {
+		l.t.Fatalf("importing %s '%s' returned %d resources", l.resourceType, importID, len(imported))
+	}
+
+	state := l.refresh(imported[0].State())
+	if state == nil {
+		l.t.Fatalf("imported %s '%s' was not found", l.resourceType, importID)
+	}
+	return state
+}
+
+// destroy deletes the resource.
+func (l *lifecycle) destroy(state *terraform.InstanceState) {
+	l.t.Helper()
+
+	newState, diags := l.resource.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, l.provider.Meta())
+	if diags.HasError() {
+		l.t.Fatalf("failed to delete %s: %v", l.resourceType, diags)
+	}
+	if newState != nil && newState.ID != "" {
+		l.t.Fatalf("%s still has ID '%s' after delete", l.resourceType, newState.ID)
+	}
+}
+
+// assertAttributes fails the test unless state holds every attribute in want.
+func assertAttributes(t *testing.T, state *terraform.InstanceState, want map[string]string) {
+	t.Helper()
+	for key, value := range want {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("attribute '%s' is %q, want %q", key, got, value)
+		}
+	}
+}
+
+// assertSameState fails the test unless imported holds the same attributes as created, as
+// ImportStateVerify checks.
+func assertSameState(t *testing.T, created, imported *terraform.InstanceState) {
+	t.Helper()
+	want := map[string]string{}
+	got := map[string]string{}
+	for k, v := range created.Attributes {
+		if !strings.HasPrefix(k, "timeouts") {
+			want[k] = v
+		}
+	}
+	for k, v := range imported.Attributes {
+		if !strings.HasPrefix(k, "timeouts") {
+			got[k] = v
+		}
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("imported state %v does not match created state %v", got, want)
+	}
+}
+
+// countRequests returns the number of requests the server served with the given method and path.
+func countRequests(server *fakejamfpro.Server, method, path string) int {
+	count := 0
+	for _, request := range server.Requests() {
+		if request == method+" "+path {
+			count++
+		}
+	}
+	return count
+}
+
+func TestResourceSiteLifecycle(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	l := newLifecycle(t, configureProvider(t, server, certPath, nil), "jamfpro_site")
+
+	// The site is not readable straight after the create, as happens while a tenant replicates
+	server.NotFoundAfterCreate("/JSSResource/sites", 2)
+	state := l.apply(nil, map[string]interface{}{"name": "London"})
+	assertAttributes(t, state, map[string]string{"id": "1", "name": "London"})
+
+	state = l.refresh(state)
+	if state == nil {
+		t.Fatal("created site was not found")
+	}
+	assertAttributes(t, state, map[string]string{"name": "London"})
+
+	assertSameState(t, state, l.importState("name:London"))
+	assertSameState(t, state, l.importState(state.ID))
+
+	l.destroy(state)
+	if l.refresh(state) != nil {
+		t.Fatal("site still exists after delete")
+	}
+	if n := countRequests(server, http.MethodDelete, "/JSSResource/sites/id/1"); n != 1 {
+		t.Fatalf("site was deleted %d times, want once", n)
+	}
+}
+
+func TestResourceBuildingLifecycle(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	l := newLifecycle(t, configureProvider(t, server, certPath, nil), "jamfpro_building")
+
+	// A server error on the create is retried
+	server.AddFault(fakejamfpro.Fault{Method: http.MethodPost, Path: "/api/v1/buildings", Status: http.StatusServiceUnavailable, Times: 1})
+	attributes := map[string]interface{}{"name": "HQ", "city": "London", "country": "United Kingdom"}
+	state := l.apply(nil, attributes)
+	assertAttributes(t, state, map[string]string{"name": "HQ", "city": "London", "country": "United Kingdom"})
+	if n := countRequests(server, http.MethodPost, "/api/v1/buildings"); n != 2 {
+		t.Fatalf("building create was sent %d times, want 2", n)
+	}
+
+	attributes["city"] = "Manchester"
+	state = l.apply(state, attributes)
+	assertAttributes(t, state, map[string]string{"city": "Manchester"})
+
+	// A slow response to the lookup by name
+	server.AddFault(fakejamfpro.Fault{Method: http.MethodGet, Path: "/api/v1/buildings", Delay: 200 * time.Millisecond, Times: 1})
+	assertSameState(t, state, l.importState("name:HQ"))
+
+	l.destroy(state)
+	if l.refresh(state) != nil {
+		t.Fatal("building still exists after delete")
+	}
+}
+
+func TestResourceCategoryLifecycle(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	l := newLifecycle(t, configureProvider(t, server, certPath, nil), "jamfpro_category")
+
+	state := l.apply(nil, map[string]interface{}{"name": "Apps"})
+	assertAttributes(t, state, map[string]string{"name": "Apps", "priority": "9"})
+
+	state = l.apply(state, map[string]interface{}{"name": "Apps", "priority": 5})
+	assertAttributes(t, state, map[string]string{"priority": "5"})
+
+	assertSameState(t, state, l.importState("name:Apps"))
+
+	l.destroy(state)
+	if l.refresh(state) != nil {
+		t.Fatal("category still exists after delete")
+	}
+}
+
+// TestResourceCategoryDeletedOutsideTerraform checks that destroying a category deleted outside
+// Terraform does not delete another category created with the same name.
+func TestResourceCategoryDeletedOutsideTerraform(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	p := configureProvider(t, server, certPath, nil)
+	l := newLifecycle(t, p, "jamfpro_category")
+
+	state := l.apply(nil, map[string]interface{}{"name": "Apps"})
+
+	conn := p.Meta().(*client.APIClient).Conn
+	if err := conn.DeleteCategoryByID(state.ID); err != nil {
+		t.Fatal(err)
+	}
+	recreated := l.apply(nil, map[string]interface{}{"name": "Apps"})
+
+	l.destroy(state)
+	if l.refresh(recreated) == nil {
+		t.Fatal("the category recreated outside Terraform was deleted")
+	}
+}
+
+// TestResourceReadOnly checks that read_only blocks creates without calling Jamf Pro.
+func TestResourceReadOnly(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	l := newLifecycle(t, configureProvider(t, server, certPath, map[string]interface{}{"read_only": true}), "jamfpro_site")
+
+	config, raw := l.config(map[string]interface{}{"name": "London"})
+	diff, err := l.resource.Diff(context.Background(), &terraform.InstanceState{Attributes: map[string]string{}, RawConfig: raw}, config, l.provider.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, diags := l.resource.Apply(context.Background(), nil, diff, l.provider.Meta()); !diags.HasError() {
+		t.Fatal("expected read_only to block the create")
+	}
+	if n := countRequests(server, http.MethodPost, "/JSSResource/sites/id/0"); n != 0 {
+		t.Fatalf("the site was sent to Jamf Pro %d times", n)
+	}
+}
+
+// TestAccResourceCategory runs the category lifecycle through the Terraform CLI. It needs TF_ACC
+// set and Terraform installed, like the other acceptance tests.
+// referenceBlock returns a site or category block referring to id and name.
+func referenceBlock(id int, name string) cty.Value {
+	return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"id": cty.NumberIntVal(int64(id)), "name": cty.StringVal(name)})})
+}
+
+// TestResourceScriptDefaultCategory checks that a script takes the provider's default category and
+// leaves it when the default is removed.
+func TestResourceScriptDefaultCategory(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	categoryID := server.SeedProAPI("categories", map[string]interface{}{"name": "Apps", "priority": 9})
+
+	attributes := map[string]interface{}{"name": "Hello", "script_contents": "#!/bin/sh\necho hello\n", "priority": "AFTER"}
+	withDefault := newLifecycle(t, configureProvider(t, server, certPath, map[string]interface{}{
+		"default_category": []interface{}{map[string]interface{}{"name": "Apps"}},
+	}), "jamfpro_script")
+	state := withDefault.apply(nil, attributes)
+	assertAttributes(t, withDefault.refresh(state), map[string]string{"category_name": "Apps", "category_id": categoryID})
+	withDefault.assertNoChanges(withDefault.refresh(state), attributes)
+
+	withoutDefault := newLifecycle(t, configureProvider(t, server, certPath, nil), "jamfpro_script")
+	state = withoutDefault.refresh(withoutDefault.apply(withoutDefault.refresh(state), attributes))
+	assertAttributes(t, state, map[string]string{"category_name": "", "category_id": "-1"})
+	withoutDefault.assertNoChanges(state, attributes)
+
+	script, err := withoutDefault.provider.Meta().(*client.APIClient).Conn.GetScriptByID(state.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script.CategoryId != "-1" {
+		t.Fatalf("script has category ID %q in Jamf Pro, want -1", script.CategoryId)
+	}
+}
+
+// TestResourcePolicyDefaultCategory checks that a policy takes the provider's default category and
+// leaves it when the default is removed.
+func TestResourcePolicyDefaultCategory(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	categoryID, err := strconv.Atoi(server.SeedProAPI("categories", map[string]interface{}{"name": "Apps", "priority": 9}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributes := map[string]interface{}{
+		"name":                      "Install Apps",
+		"enabled":                   true,
+		"trigger":                   "EVENT",
+		"site":                      referenceBlock(-1, "None"),
+		"override_default_settings": item(map[string]interface{}{}),
+		"scope":                     item(map[string]interface{}{"all_computers": true}),
+		"self_service":              item(map[string]interface{}{"self_service_icon": item(map[string]interface{}{})}),
+	}
+	withDefault := newLifecycle(t, configureProvider(t, server, certPath, map[string]interface{}{
+		"default_category": []interface{}{map[string]interface{}{"id": categoryID}},
+	}), "jamfpro_policy")
+	state := withDefault.refresh(withDefault.apply(nil, attributes))
+	assertAttributes(t, state, map[string]string{"category.#": "1", "category.0.id": strconv.Itoa(categoryID), "category.0.name": "Apps"})
+	withDefault.assertNoChanges(state, attributes)
+
+	withoutDefault := newLifecycle(t, configureProvider(t, server, certPath, nil), "jamfpro_policy")
+	state = withoutDefault.refresh(withoutDefault.apply(state, attributes))
+	assertAttributes(t, state, map[string]string{"category.#": "0"})
+	withoutDefault.assertNoChanges(state, attributes)
+
+	id, err := strconv.Atoi(state.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy, err := withoutDefault.provider.Meta().(*client.APIClient).Conn.GetPolicyByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy.General.Category.ID != -1 {
+		t.Fatalf("policy has category ID %d in Jamf Pro, want -1", policy.General.Category.ID)
+	}
+}
+
+// item wraps attributes in a single element list, the shape of every block in a configuration.
+func item(attributes map[string]interface{}) []interface{} {
+	return []interface{}{attributes}
+}
+
+// TestResourcePolicyLifecycle checks that a policy setting every block plans no changes once
+// created, so that each block survives the round trip through Jamf Pro.
+func TestResourcePolicyLifecycle(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	categoryID, err := strconv.Atoi(server.SeedProAPI("categories", map[string]interface{}{"name": "Apps", "priority": 9}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reference := func(key string, id int) map[string]interface{} {
+		return map[string]interface{}{key: item(map[string]interface{}{"id": id})}
+	}
+
+	attributes := map[string]interface{}{
+		"name":                          "Install Apps",
+		"enabled":                       true,
+		"trigger":                       "EVENT",
+		"trigger_checkin":               true,
+		"trigger_enrollment_complete":   true,
+		"trigger_login":                 true,
+		"trigger_logout":                true,
+		"trigger_network_state_changed": true,
+		"trigger_startup":               true,
+		"trigger_other":                 "install-apps",
+		"frequency":                     "Ongoing",
+		"retry_event":                   "check-in",
+		"retry_attempts":                3,
+		"notify_on_each_failed_retry":   true,
+		"location_user_only":            true,
+		"target_drive":                  "/Volumes/Data",
+		"offline":                       true,
+		"network_requirements":          "Ethernet",
+		"category":                      item(map[string]interface{}{"id": categoryID, "name": "Apps"}),
+		"site":                          referenceBlock(-1, "None"),
+		"date_time_limitations": item(map[string]interface{}{
+			"activation_date":       "2026-01-01 09:00:00",
+			"activation_date_epoch": 1767258000000,
+			"activation_date_utc":   "2026-01-01T09:00:00.000+0000",
+			"expiration_date":       "2027-01-01 09:00:00",
+			"expiration_date_epoch": 1798794000000,
+			"expiration_date_utc":   "2027-01-01T09:00:00.000+0000",
+			"no_execute_on":         []interface{}{"Sun", "Sat"},
+			"no_execute_start":      "10:00 PM",
+			"no_execute_end":        "6:00 AM",
+		}),
+		"network_limitations": item(map[string]interface{}{
+			"minimum_network_connection": "Ethernet",
+			"any_ip_address":             false,
+			"network_segments":           "Office",
+		}),
+		"override_default_settings": item(map[string]interface{}{
+			"target_drive":       "/Volumes/Data",
+			"distribution_point": "Cloud",
+			"force_afp_smb":      true,
+			"sus":                "Apple",
+		}),
+		"scope": item(map[string]interface{}{
+			"all_computers":   false,
+			"computers":       []interface{}{reference("computer", 1), reference("computer", 2)},
+			"computer_groups": []interface{}{reference("computer_group", 3)},
+			"jss_users":       []interface{}{reference("jss_user", 4)},
+			"jss_user_groups": item(map[string]interface{}{"id": 5}),
+			"buildings":       []interface{}{reference("building", 6)},
+			"departments":     []interface{}{reference("department", 7)},
+			"limitations": item(map[string]interface{}{
+				"network_segments": []interface{}{reference("network_segment", 8)},
+				"users":            []interface{}{reference("user", 9)},
+				"user_groups":      []interface{}{reference("user_group", 10)},
+				"ibeacons":         []interface{}{reference("ibeacon", 11)},
+			}),
+			"exclusions": item(map[string]interface{}{
+				"computers":        []interface{}{reference("computer", 12)},
+				"computer_groups":  []interface{}{reference("computer_group", 13)},
+				"jss_users":        []interface{}{reference("jss_user", 14)},
+				"jss_user_groups":  []interface{}{reference("jss_user_group", 15)},
+				"buildings":        []interface{}{reference("building", 16)},
+				"departments":      []interface{}{reference("department", 17)},
+				"network_segments": []interface{}{reference("network_segment", 18)},
+				"users":            []interface{}{reference("user", 19)},
+				"user_groups":      []interface{}{reference("user_group", 20)},
+				"ibeacons":         []interface{}{reference("ibeacon", 21)},
+			}),
+		}),
+		"self_service": item(map[string]interface{}{
+			"use_for_self_service":            true,
+			"self_service_display_name":       "Apps",
+			"install_button_text":             "Get",
+			"reinstall_button_text":           "Get Again",
+			"self_service_description":        "Installs the standard apps.",
+			"force_users_to_view_description": true,
+			"feature_on_main_page":            true,
+			"self_service_icon":               item(map[string]interface{}{"id": 22}),
+			"self_service_categories": item(map[string]interface{}{
+				"category": item(map[string]interface{}{"id": categoryID, "name": "Apps", "display_in": true, "feature_in": true}),
+			}),
+		}),
+		"package_configuration": item(map[string]interface{}{
+			"packages": item(map[string]interface{}{"package": []interface{}{
+				map[string]interface{}{"id": 23, "action": "Cache", "fut": true, "feu": true, "update_autorun": true},
+				map[string]interface{}{"id": 24},
+			}}),
+		}),
+		"scripts": item(map[string]interface{}{"script": []interface{}{
+			map[string]interface{}{
+				"id": 25, "name": "Prepare", "priority": "Before",
+				"parameter4": "4", "parameter5": "5", "parameter6": "6", "parameter7": "7",
+				"parameter8": "8", "parameter9": "9", "parameter10": "10", "parameter11": "11",
+			},
+			map[string]interface{}{"id": 26, "name": "Clean Up"},
+		}}),
+		"printers": item(map[string]interface{}{
+			"leave_existing_default": true,
+			"printer":                item(map[string]interface{}{"id": 27, "action": "install", "make_default": true}),
+		}),
+		"dock_items": item(map[string]interface{}{
+			"dock_item": item(map[string]interface{}{"id": 28, "action": "Add To End"}),
+		}),
+		"account_maintenance": item(map[string]interface{}{
+			"accounts": item(map[string]interface{}{"account": item(map[string]interface{}{
+				"action":                    "Create",
+				"username":                  "helpdesk",
+				"realname":                  "Help Desk",
+				"password":                  "Sup3r-Secret",
+				"archive_home_directory":    true,
+				"archive_home_directory_to": "/Users/Shared",
+				"home":                      "/Users/helpdesk",
+				"hint":                      "ask IT",
+				"picture":                   "/Library/User Pictures/Animals/Eagle.tif",
+				"admin":                     true,
+				"filevault_enabled":         true,
+			})}),
+			"directory_bindings": item(map[string]interface{}{"binding": item(map[string]interface{}{"id": 29})}),
+			"management_account": item(map[string]interface{}{
+				"action":                  "specified",
+				"managed_password":        "Sup3r-Secret",
+				"managed_password_length": 0,
+			}),
+			"open_firmware_efi_password": item(map[string]interface{}{"of_mode": "command", "of_password": "Firmware-Secret"}),
+		}),
+		"reboot": item(map[string]interface{}{
+			"message":                        "Restarting for updates.",
+			"specify_startup":                "Immediately",
+			"startup_disk":                   "Specify Local Startup Disk",
+			"no_user_logged_in":              "Restart Immediately",
+			"user_logged_in":                 "Restart",
+			"minutes_until_reboot":           10,
+			"start_reboot_timer_immediately": true,
+			"file_vault_2_reboot":            true,
+		}),
+		"maintenance": item(map[string]interface{}{
+			"recon": true, "reset_name": true, "install_all_cached_packages": true, "heal": true, "prebindings": true,
+			"permissions": true, "byhost": true, "system_cache": true, "user_cache": true, "verify": true,
+		}),
+		"files_processes": item(map[string]interface{}{
+			"search_by_path":         "/Applications/Old.app",
+			"delete_file":            true,
+			"locate_file":            "Old.app",
+			"update_locate_database": true,
+			"spotlight_search":       "Old",
+			"search_for_process":     "Old",
+			"kill_process":           true,
+			"run_command":            "/usr/bin/true",
+		}),
+		"user_interaction": item(map[string]interface{}{
+			"message_start":            "Installing apps.",
+			"allow_user_to_defer":      true,
+			"allow_deferral_until_utc": "2027-01-01T09:00:00.000+0000",
+			"allow_deferral_minutes":   60,
+			"message_finish":           "Apps installed.",
+		}),
+		"disk_encryption": item(map[string]interface{}{
+			"action":                           "apply",
+			"disk_encryption_configuration_id": 30,
+			"auth_restart":                     true,
+			"remediate_key_type":               "Individual",
+			"remediate_disk_encryption_configuration_id": 31,
+		}),
+	}
+
+	l := newLifecycle(t, configureProvider(t, server, certPath, nil), "jamfpro_policy")
+	state := l.refresh(l.apply(nil, attributes))
+	assertAttributes(t, state, map[string]string{
+		"scope.0.exclusions.0.ibeacons.0.ibeacon.0.id":                   "21",
+		"package_configuration.0.packages.0.package.1.action":            "Install",
+		"scripts.0.script.1.priority":                                    "After",
+		"account_maintenance.0.accounts.0.account.0.password":            "Sup3r-Secret",
+		"account_maintenance.0.open_firmware_efi_password.0.of_password": "Firmware-Secret",
+		"self_service.0.self_service_categories.0.category.0.feature_in": "true",
+		"date_time_limitations.0.no_execute_on.#":                        "2",
+		"disk_encryption.0.remediate_disk_encryption_configuration_id":   "31",
+	})
+	l.assertNoChanges(state, attributes)
+
+	imported := l.importState(state.ID)
+	l.assertNoChanges(imported, attributes)
+	l.destroy(state)
+}
+
+// TestResourceGroupMembershipImport checks that importing a group membership records every member of
+// the group, and that members dropped from the configuration afterwards are removed from the group.
+func TestResourceGroupMembershipImport(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	p := configureProvider(t, server, certPath, nil)
+
+	for _, tc := range []struct {
+		resourceType, collection, body, key string
+	}{
+		{
+			resourceType: "jamfpro_computer_group_membership",
+			collection:   "computergroups",
+			body:         "<computer_group><name>Lab</name><is_smart>false</is_smart><computers><computer><id>1</id></computer><computer><id>2</id></computer><computer><id>3</id></computer></computers></computer_group>",
+			key:          "computer_ids",
+		},
+		{
+			resourceType: "jamfpro_user_group_membership",
+			collection:   "usergroups",
+			body:         "<user_group><name>Staff</name><is_smart>false</is_smart><users><user><id>1</id></user><user><id>2</id></user><user><id>3</id></user></users></user_group>",
+			key:          "user_ids",
+		},
+	} {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			groupID, err := server.SeedClassic(tc.collection, tc.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l := newLifecycle(t, p, tc.resourceType)
+
+			state := l.importState(strconv.Itoa(groupID))
+			assertAttributes(t, state, map[string]string{"group_id": strconv.Itoa(groupID), tc.key + ".#": "3"})
+
+			members := func(ids ...int) map[string]interface{} {
+				values := make([]cty.Value, 0, len(ids))
+				for _, id := range ids {
+					values = append(values, cty.NumberIntVal(int64(id)))
+				}
+				return map[string]interface{}{"group_id": strconv.Itoa(groupID), tc.key: cty.SetVal(values)}
+			}
+			l.assertNoChanges(state, members(1, 2, 3))
+
+			state = l.refresh(l.apply(state, members(1, 3)))
+			assertAttributes(t, state, map[string]string{tc.key + ".#": "2"})
+			l.assertNoChanges(state, members(1, 3))
+
+			l.destroy(state)
+			imported := l.importState(strconv.Itoa(groupID))
+			assertAttributes(t, imported, map[string]string{tc.key + ".#": "0"})
+		})
+	}
+}
+
+// TestResourceComputerPrestageScopeLifecycle assigns more serial numbers than fit in a single
+// scope request, with the first batch rejected for a stale version lock, then removes most of them.
+func TestResourceComputerPrestageScopeLifecycle(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	l := newLifecycle(t, configureProvider(t, server, certPath, nil), "jamfpro_computer_prestage_scope")
+
+	var serials, devices []interface{}
+	for i := 1; i <= 1500; i++ {
+		serial := fmt.Sprintf("C02%06d", i)
+		serials = append(serials, serial)
+		devices = append(devices, map[string]interface{}{"serialNumber": serial})
+	}
+	instanceID := server.SeedProAPI("device-enrollments", map[string]interface{}{"name": "Apple Business Manager"})
+	server.SetProAPISingleton("device-enrollments/"+instanceID+"/devices", map[string]interface{}{"totalCount": len(devices), "results": devices})
+	prestageID := server.SeedProAPI("computer-prestages", map[string]interface{}{"displayName": "Staff Macs", "deviceEnrollmentProgramInstanceId": instanceID})
+	server.SetProAPISingleton("computer-prestages/"+prestageID+"/scope", map[string]interface{}{
+		"prestageId":  prestageID,
+		"assignments": []interface{}{map[string]interface{}{"serialNumber": "C02STALE01"}},
+		"versionLock": float64(3),
+	})
+
+	scopePath := "/api/v2/computer-prestages/" + prestageID + "/scope/"
+	server.AddFault(fakejamfpro.Fault{Method: http.MethodPost, Path: scopePath + "add-multiple", Status: http.StatusConflict, Times: 1})
+
+	attributes := func(serials []interface{}) map[string]interface{} {
+		return map[string]interface{}{"prestage_id": prestageID, "serial_numbers": serials}
+	}
+	configured := append(append([]interface{}{}, serials...), "C02UNASSIGNED")
+	state := l.apply(nil, attributes(configured))
+	assertAttributes(t, state, map[string]string{
+		"id":                          prestageID,
+		"mode":                        "authoritative",
+		"serial_numbers.#":            "1500",
+		"unassigned_serial_numbers.#": "1",
+	})
+	// The rejected first batch is sent again after the scope is read, then the second batch
+	if n := countRequests(server, http.MethodPost, scopePath+"add-multiple"); n != 3 {
+		t.Errorf("serial numbers were added in %d requests, want 3", n)
+	}
+	if n := countRequests(server, http.MethodPost, scopePath+"delete-multiple"); n != 1 {
+		t.Errorf("the unmanaged serial number was removed in %d requests, want 1", n)
+	}
+
+	state = l.refresh(state)
+	assertAttributes(t, state, map[string]string{"serial_numbers.#": "1500"})
+	l.assertNoChanges(state, attributes(serials), "serial_numbers")
+
+	state = l.refresh(l.apply(state, attributes(serials[:300])))
+	assertAttributes(t, state, map[string]string{"serial_numbers.#": "300"})
+	if n := countRequests(server, http.MethodPost, scopePath+"delete-multiple"); n != 3 {
+		t.Errorf("serial numbers were removed in %d requests, want 3", n)
+	}
+	l.assertNoChanges(state, attributes(serials[:300]), "serial_numbers")
+
+	imported := l.importState(prestageID)
+	assertAttributes(t, imported, map[string]string{"prestage_id": prestageID, "serial_numbers.#": "300"})
+	l.assertNoChanges(imported, attributes(serials[:300]), "serial_numbers")
+
+	l.destroy(state)
+	assertAttributes(t, l.refresh(state), map[string]string{"serial_numbers.#": "0"})
+}
+
+// readDataSource reads the data source dataSourceType configured with attributes.
+func readDataSource(t *testing.T, p *schema.Provider, dataSourceType string, attributes map[string]interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+
+	dataSource := p.DataSourcesMap[dataSourceType]
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, attributes)
+	diags := dataSource.ReadContext(context.Background(), d, p.Meta())
+	return d.State(), diags
+}
+
+// TestDataSourceMobileDeviceInventory looks up a single mobile device by each of its identifiers and
+// searches an inventory that spans more than one page.
+func TestDataSourceMobileDeviceInventory(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	p := configureProvider(t, server, certPath, nil)
+
+	for i := 1; i <= 150; i++ {
+		server.SeedProAPI("mobile-devices", map[string]interface{}{
+			"deviceType": "iOS",
+			"general":    map[string]interface{}{"displayName": fmt.Sprintf("iPad %03d", i), "udid": fmt.Sprintf("UDID-%03d", i), "supervised": true},
+			"hardware":   map[string]interface{}{"serialNumber": fmt.Sprintf("DMP%05d", i), "model": "iPad Air"},
+		})
+		// Group memberships are read from the Classic API record with the same ID
+		if _, err := server.SeedClassic("mobiledevices", fmt.Sprintf("<mobile_device><general><name>iPad %03d</name></general>"+
+			"<mobile_device_groups><mobile_device_group><id>4</id><name>Staff iPads</name></mobile_device_group></mobile_device_groups></mobile_device>", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name       string
+		attributes map[string]interface{}
+		want       map[string]string
+	}{
+		{
+			name:       "id with general section",
+			attributes: map[string]interface{}{"id": "2", "sections": []interface{}{"general"}},
+			want: map[string]string{
+				"id": "2", "name": "iPad 002", "udid": "UDID-002", "serial_number": "", "device_type": "iOS",
+				"general.0.supervised": "true", "group_memberships.#": "",
+			},
+		},
+		{
+			name:       "name",
+			attributes: map[string]interface{}{"name": "iPad 007"},
+			want: map[string]string{
+				"id": "7", "serial_number": "DMP00007", "hardware.0.model": "iPad Air",
+				"group_memberships.#": "1", "group_memberships.0.group_id": "4", "group_memberships.0.group_name": "Staff iPads",
+			},
+		},
+		{
+			name:       "serial number",
+			attributes: map[string]interface{}{"serial_number": "DMP00120"},
+			want:       map[string]string{"id": "120", "name": "iPad 120", "udid": "UDID-120"},
+		},
+		{
+			name:       "udid",
+			attributes: map[string]interface{}{"udid": "UDID-150"},
+			want:       map[string]string{"id": "150", "name": "iPad 150", "serial_number": "DMP00150"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state, diags := readDataSource(t, p, "jamfpro_mobile_device_inventory", tc.attributes)
+			if diags.HasError() {
+				t.Fatalf("failed to read the mobile device: %v", diags)
+			}
+			assertAttributes(t, state, tc.want)
+		})
+	}
+
+	_, diags := readDataSource(t, p, "jamfpro_mobile_device_inventory", map[string]interface{}{"name": "iPad 999"})
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "no Jamf Pro Mobile Device with name 'iPad 999' was found") {
+		t.Errorf("reading a missing mobile device returned %v", diags)
+	}
+
+	before := countRequests(server, http.MethodGet, "/api/v2/mobile-devices/detail")
+	state, diags := readDataSource(t, p, "jamfpro_mobile_devices_inventory", map[string]interface{}{"sections": []interface{}{"general", "hardware"}})
+	if diags.HasError() {
+		t.Fatalf("failed to search the mobile devices: %v", diags)
+	}
+	assertAttributes(t, state, map[string]string{
+		"ids.#":                        "150",
+		"ids.149":                      "150",
+		"mobile_devices.#":             "150",
+		"mobile_devices.0.id":          "1",
+		"mobile_devices.0.device_type": "iOS",
+		"mobile_devices.0.general.0.display_name":     "iPad 001",
+		"mobile_devices.149.hardware.0.serial_number": "DMP00150",
+		"mobile_devices.149.security.#":               "0",
+	})
+	if n := countRequests(server, http.MethodGet, "/api/v2/mobile-devices/detail") - before; n != 2 {
+		t.Errorf("the inventory was read in %d pages, want 2", n)
+	}
+}
+
+// TestResourceReadErrorDiagnostics checks that a read failing with an error other than 404 reports
+// the HTTP status and the object that could not be read.
+func TestResourceReadErrorDiagnostics(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	p := configureProvider(t, server, certPath, nil)
+	if _, err := server.SeedClassic("printers", `<printer><name>Office</name><category>No category assigned</category></printer>`); err != nil {
+		t.Fatal(err)
+	}
+	server.AddFault(fakejamfpro.Fault{Method: http.MethodGet, Path: "/JSSResource/printers/id/1", Status: http.StatusForbidden})
+
+	resource := p.ResourcesMap["jamfpro_printer"]
+	_, diags := resource.RefreshWithoutUpgrade(context.Background(), &terraform.InstanceState{ID: "1", Attributes: map[string]string{"id": "1"}}, p.Meta())
+	if !diags.HasError() {
+		t.Fatal("expected the read to fail")
+	}
+	if summary := diags[0].Summary; !strings.Contains(summary, "failed to read Jamf Pro Printer with ID '1'") {
+		t.Errorf("summary %q does not name the printer", summary)
+	}
+	if detail := diags[0].Detail; !strings.Contains(detail, "HTTP 403") {
+		t.Errorf("detail %q does not include the HTTP status", detail)
+	}
+}
+
+// TestProviderAliasesKeepTheirTransports checks that the TLS settings of one provider configuration
+// do not apply to another pointed at the same Jamf Pro host.
+func TestProviderAliasesKeepTheirTransports(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+	trusting := configureProvider(t, server, certPath, nil)
+	untrusting := configureProvider(t, server, certPath, map[string]interface{}{"ca_bundle_file": ""})
+
+	// The certificate is rejected during the TLS handshake, so the server never sees a request
+	if _, err := untrusting.Meta().(*client.APIClient).Conn.GetSites(); err == nil {
+		t.Fatal("expected the server certificate to be rejected without ca_bundle_file")
+	}
+	if n := len(server.Requests()); n != 0 {
+		t.Fatalf("the server received %d requests without ca_bundle_file", n)
+	}
+	if _, err := trusting.Meta().(*client.APIClient).Conn.GetSites(); err != nil {
+		t.Fatalf("failed to list sites with ca_bundle_file: %v", err)
+	}
+}
+
+// TestProviderAliasesKeepTheirCredentials checks that the credential source of one provider
+// configuration does not replace the credentials of another pointed at the same Jamf Pro tenant.
+func TestProviderAliasesKeepTheirCredentials(t *testing.T) {
+	server, certPath := startFakeJamfPro(t)
+
+	secretFile := filepath.Join(t.TempDir(), "client_secret")
+	if err := os.WriteFile(secretFile, []byte("Revoked-Client-Secret-0\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	static := configureProvider(t, server, certPath, nil)
+	fromFile := configureProvider(t, server, certPath, map[string]interface{}{"client_secret": "", "client_secret_file": secretFile})
+
+	if _, err := static.Meta().(*client.APIClient).Conn.GetSites(); err != nil {
+		t.Fatalf("failed to list sites with the static credentials: %v", err)
+	}
+	if _, err := fromFile.Meta().(*client.APIClient).Conn.GetSites(); err == nil {
+		t.Fatal("expected the revoked secret from client_secret_file to be rejected")
+	}
+}
+
+func TestAccResourceCategory(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("set %s to run acceptance tests", resource.EnvTfAcc)
+	}
+	server, certPath := startFakeJamfPro(t)
+
+	config := func(priority int) string {
+		return server.ProviderConfig(certPath) + fmt.Sprintf(`
+resource "jamfpro_category" "apps" {
+  name     = "Apps"
+  priority = %d
+}
+`, priority)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"jamfpro": func() (*schema.Provider, error) { return Provider(), nil },
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(9),
+				Check:  resource.TestCheckResourceAttr("jamfpro_category.apps", "priority", "9"),
+			},
+			{
+				Config: config(5),
+				Check:  resource.TestCheckResourceAttr("jamfpro_category.apps", "priority", "5"),
+			},
+			{
+				ResourceName:      "jamfpro_category.apps",
+				ImportState:       true,
+				ImportStateId:     "name:Apps",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -21,6 +21,17 @@ func EncodeMapToPlist(data map[string]interface{}, format int) error {
 	return encoder.Encode(data)
 }
 
+// EncodeMapToPlistString encodes a map[string]interface{} to an indented plist of the given format
+// and returns it as a string. Dictionary keys are written in sorted order, so equal maps always
+// encode to the same string.
+func EncodeMapToPlistString(data map[string]interface{}, format int) (string, error) {
+	encoded, err := plist.MarshalIndent(data, format, "\t")
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
 // DecodePlistToMap decodes plist data from a byte slice into a map[string]interface{}.
 // Returns the decoded map, the format of the plist, and an error if decoding fails.
 func DecodePlistToMap(data []byte) (map[string]interface{}, int, error) {
@@ -6,9 +6,10 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
-	"strings"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/helpers/provider_diagnostics"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,7 +24,8 @@ type APICallFunc func(interface{}) (interface{}, error)
 //
 // The function uses an APICallFunc to repeatedly check for the existence of the resource,
 // retrying in the face of "resource not found" errors, which are common immediately after
-// resource creation. Other types of errors are not retried and lead to an immediate return.
+// resource creation. Rate limiting and server errors are also retried; other types of errors
+// lead to an immediate return.
 //
 // Exponential backoff helps in efficiently spacing out retry attempts to reduce load on the
 // server and minimize the chance of failures due to rate limiting or server overload. Jitter
@@ -72,7 +74,7 @@ func ResourceIsAvailable(ctx context.Context, d *schema.ResourceData, resourceTy
 		if apiErr != nil {
 			log.Printf("Error fetching %s resource with ID '%v': %v (Retry #%d)", resourceType, resourceID, apiErr, retryCount)
 
-			if strings.Contains(apiErr.Error(), "404") || strings.Contains(apiErr.Error(), "410") {
+			if provider_diagnostics.IsNotFound(apiErr) {
 				log.Printf("Resource with ID '%v' not found, retrying with backoff of %v (Retry #%d)", resourceID, currentBackoff, retryCount)
 				time.Sleep(currentBackoff + time.Duration(rand.Float64()*jitterFactor*float64(currentBackoff)))
 				currentBackoff = time.Duration(float64(currentBackoff) * backoffFactor)
@@ -83,8 +85,8 @@ func ResourceIsAvailable(ctx context.Context, d *schema.ResourceData, resourceTy
 				return retry.RetryableError(apiErr)
 			}
 
-			// Return a non-retryable error to exit the retry loop.
-			return retry.NonRetryableError(apiErr)
+			// Retry transient failures such as rate limiting or server errors, exit the loop on anything else.
+			return provider_diagnostics.RetryError(apiErr)
 		}
 
 		log.Printf("%s resource with ID '%v' found after %d retries. Initiating a stabilization period of %v.", resourceType, resourceID, retryCount, stabilizationTime)
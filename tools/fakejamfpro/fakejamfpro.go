@@ -0,0 +1,56 @@
+// Command fakejamfpro runs the in-memory Jamf Pro stand-in used by the provider's tests so that
+// configurations can be planned and applied locally without a Jamf Pro tenant.
+//
+//	go run ./tools/fakejamfpro -listen 127.0.0.1:8443 -cert /tmp/fakejamfpro.pem
+//
+// The provider block and environment variables needed to reach the server are printed on start.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/fakejamfpro"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:0", "address to listen on")
+	certPath := flag.String("cert", "fakejamfpro.pem", "path to write the server's TLS certificate to")
+	flag.Parse()
+
+	listener, err := net.Listen("tcp", *listen)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *listen, err)
+	}
+
+	server := fakejamfpro.NewWithListener(listener)
+	defer server.Close()
+
+	if err := server.WriteCertificate(*certPath); err != nil {
+		log.Fatalf("failed to write certificate: %v", err)
+	}
+
+	env := server.Environment(*certPath)
+	env["SSL_CERT_FILE"] = *certPath
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Printf("Fake Jamf Pro listening on %s\n", server.URL)
+	fmt.Println(server.ProviderConfig(*certPath))
+	for _, k := range keys {
+		fmt.Printf("export %s=%q\n", k, env[k])
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	<-signals
+}